@@ -0,0 +1,96 @@
+package influxdbv2
+
+import (
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Authentication methods of the provider, from the highest to the lowest precedence.
+const (
+	authMethodToken     = "token"
+	authMethodTokenFile = "token_file"
+	authMethodPassword  = "password"
+)
+
+// providerAuth holds the authentication options resolved from the provider
+// configuration block and environment variables.
+type providerAuth struct {
+	token string
+	// tokenSource is where token was taken from, 'attribute', 'profile' or 'environment'.
+	tokenSource string
+	tokenFile   string
+	username    string
+	password    string
+}
+
+// Helper function to pick the authentication method among the configured ones.
+// Setting several of them is allowed, the first one of token, token_file and
+// username/password wins and a warning tells which one is used.
+func (a providerAuth) method() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if a.username != "" && a.password == "" {
+		diags.AddAttributeError(
+			path.Root("password"),
+			"Missing InfluxDB Password Configuration",
+			"The username is set but the password was not found in the INFLUXDB_V2_PASSWORD environment "+
+				"variable or provider configuration block password attribute.",
+		)
+	}
+	if a.password != "" && a.username == "" {
+		diags.AddAttributeError(
+			path.Root("username"),
+			"Missing InfluxDB Username Configuration",
+			"The password is set but the username was not found in the INFLUXDB_V2_USERNAME environment "+
+				"variable or provider configuration block username attribute.",
+		)
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+
+	var methods []string
+	if a.token != "" {
+		methods = append(methods, authMethodToken)
+	}
+	if a.tokenFile != "" {
+		methods = append(methods, authMethodTokenFile)
+	}
+	if a.username != "" {
+		methods = append(methods, authMethodPassword)
+	}
+
+	if len(methods) == 0 {
+		diags.AddError(
+			"Missing InfluxDB Authentication Configuration",
+			"While configuring the provider, no authentication was found. Set the token attribute "+
+				"(INFLUXDB_V2_TOKEN environment variable), the token_file attribute (INFLUXDB_V2_TOKEN_FILE) "+
+				"or the username and password attributes (INFLUXDB_V2_USERNAME and INFLUXDB_V2_PASSWORD).",
+		)
+		return "", diags
+	}
+
+	if len(methods) > 1 {
+		diags.AddWarning(
+			"Multiple InfluxDB Authentication Sources",
+			"Several authentication options are set: "+strings.Join(methods, ", ")+". "+
+				"They take precedence in the order token, token_file, then username and password, "+
+				"so "+methods[0]+" is used and the others are ignored. Environment variables count as set.",
+		)
+	}
+
+	return methods[0], diags
+}
+
+// Helper function to read a token from a file, ignoring surrounding whitespace
+// such as a trailing newline.
+func readTokenFile(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
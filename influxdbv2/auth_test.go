@@ -0,0 +1,111 @@
+package influxdbv2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProviderAuthMethod(t *testing.T) {
+	tests := []struct {
+		name     string
+		auth     providerAuth
+		expected string
+		warning  bool
+		error    bool
+	}{
+		{
+			name:  "nothing set",
+			auth:  providerAuth{},
+			error: true,
+		},
+		{
+			name:     "token",
+			auth:     providerAuth{token: "t"},
+			expected: authMethodToken,
+		},
+		{
+			name:     "token file",
+			auth:     providerAuth{tokenFile: "/run/secrets/influxdb"},
+			expected: authMethodTokenFile,
+		},
+		{
+			name:     "username and password",
+			auth:     providerAuth{username: "admin", password: "secret"},
+			expected: authMethodPassword,
+		},
+		{
+			name:  "username without password",
+			auth:  providerAuth{username: "admin"},
+			error: true,
+		},
+		{
+			name:  "password without username",
+			auth:  providerAuth{password: "secret"},
+			error: true,
+		},
+		{
+			name:     "token and token file",
+			auth:     providerAuth{token: "t", tokenFile: "/run/secrets/influxdb"},
+			expected: authMethodToken,
+			warning:  true,
+		},
+		{
+			name:     "token and username and password",
+			auth:     providerAuth{token: "t", username: "admin", password: "secret"},
+			expected: authMethodToken,
+			warning:  true,
+		},
+		{
+			name:     "token file and username and password",
+			auth:     providerAuth{tokenFile: "/run/secrets/influxdb", username: "admin", password: "secret"},
+			expected: authMethodTokenFile,
+			warning:  true,
+		},
+		{
+			name:     "everything set",
+			auth:     providerAuth{token: "t", tokenFile: "/run/secrets/influxdb", username: "admin", password: "secret"},
+			expected: authMethodToken,
+			warning:  true,
+		},
+		{
+			name:  "token and incomplete credentials",
+			auth:  providerAuth{token: "t", username: "admin"},
+			error: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method, diags := test.auth.method()
+			if diags.HasError() != test.error {
+				t.Fatalf("expected error %t, got diagnostics %v", test.error, diags)
+			}
+			if warning := diags.WarningsCount() > 0; warning != test.warning {
+				t.Errorf("expected warning %t, got diagnostics %v", test.warning, diags)
+			}
+			if method != test.expected {
+				t.Errorf("expected method %q, got %q", test.expected, method)
+			}
+		})
+	}
+}
+
+func TestReadTokenFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(name, []byte("  my-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := readTokenFile(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "my-token" {
+		t.Errorf("expected %q, got %q", "my-token", token)
+	}
+
+	if _, err := readTokenFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,169 @@
+package influxdbv2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// authorizationsCacheTTL is how long a listing of the authorizations of an
+// organization is reused. It only has to cover a single refresh.
+const authorizationsCacheTTL = 10 * time.Second
+
+// authorizationsCache shares the authorizations of an organization between
+// the reads of a provider instance, so that refreshing many authorization
+// resources lists each organization once instead of once per resource.
+type authorizationsCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*authorizationsCacheEntry
+}
+
+// authorizationsCacheEntry is a listing, possibly still in progress.
+type authorizationsCacheEntry struct {
+	done           chan struct{}
+	expires        time.Time
+	authorizations []domain.Authorization
+	err            error
+}
+
+func newAuthorizationsCache(ttl time.Duration) *authorizationsCache {
+	return &authorizationsCache{
+		ttl:     ttl,
+		entries: map[string]*authorizationsCacheEntry{},
+	}
+}
+
+// list returns the authorizations of an organization, calling fetch unless a
+// recent listing exists. Concurrent callers wait for a listing in progress.
+// The returned slice is shared and must not be modified.
+func (c *authorizationsCache) list(ctx context.Context, orgID string, fetch func(ctx context.Context) (*[]domain.Authorization, error)) ([]domain.Authorization, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[orgID]
+	if !ok || time.Now().After(entry.expires) {
+		entry = &authorizationsCacheEntry{
+			done:    make(chan struct{}),
+			expires: time.Now().Add(c.ttl),
+		}
+		c.entries[orgID] = entry
+		c.mu.Unlock()
+
+		authorizations, err := fetch(ctx)
+		if authorizations != nil {
+			entry.authorizations = *authorizations
+		}
+		entry.err = err
+
+		// Failed listings are not reused.
+		c.mu.Lock()
+		if err != nil && c.entries[orgID] == entry {
+			delete(c.entries, orgID)
+		}
+		c.mu.Unlock()
+		close(entry.done)
+
+		return entry.authorizations, entry.err
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-entry.done:
+	}
+
+	return entry.authorizations, entry.err
+}
+
+// invalidate drops the listing of an organization after a change.
+func (c *authorizationsCache) invalidate(orgID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, orgID)
+}
+
+// organizationsCache shares organization lookups between the resources and
+// data sources of a provider instance, so that an organization referenced
+// many times is resolved once, by name or by ID.
+type organizationsCache struct {
+	// disabled makes every lookup call fetch, for debugging stale results.
+	disabled bool
+
+	mu     sync.Mutex
+	byName map[string]*organizationsCacheEntry
+	byID   map[string]*organizationsCacheEntry
+}
+
+// organizationsCacheEntry is a lookup, possibly still in progress.
+type organizationsCacheEntry struct {
+	done         chan struct{}
+	organization *domain.Organization
+	err          error
+}
+
+func newOrganizationsCache() *organizationsCache {
+	return &organizationsCache{
+		byName: map[string]*organizationsCacheEntry{},
+		byID:   map[string]*organizationsCacheEntry{},
+	}
+}
+
+// findByName returns the organization with the given name, calling fetch
+// unless it was already resolved by name or ID.
+func (c *organizationsCache) findByName(ctx context.Context, name string, fetch func(ctx context.Context) (*domain.Organization, error)) (*domain.Organization, error) {
+	return c.find(ctx, c.byName, name, fetch)
+}
+
+// findByID returns the organization with the given ID, calling fetch unless
+// it was already resolved by name or ID.
+func (c *organizationsCache) findByID(ctx context.Context, id string, fetch func(ctx context.Context) (*domain.Organization, error)) (*domain.Organization, error) {
+	return c.find(ctx, c.byID, id, fetch)
+}
+
+// find looks up an organization in one of the indexes. Concurrent callers
+// wait for a lookup in progress, and a successful lookup fills both indexes.
+func (c *organizationsCache) find(ctx context.Context, entries map[string]*organizationsCacheEntry, key string, fetch func(ctx context.Context) (*domain.Organization, error)) (*domain.Organization, error) {
+	if c.disabled {
+		return fetch(ctx)
+	}
+
+	c.mu.Lock()
+	entry, ok := entries[key]
+	if !ok {
+		entry = &organizationsCacheEntry{done: make(chan struct{})}
+		entries[key] = entry
+		c.mu.Unlock()
+
+		entry.organization, entry.err = fetch(ctx)
+
+		c.mu.Lock()
+		if entry.err != nil {
+			// Failed lookups are not reused.
+			if entries[key] == entry {
+				delete(entries, key)
+			}
+		} else if entry.organization != nil {
+			if entry.organization.Id != nil {
+				c.byID[*entry.organization.Id] = entry
+			}
+			c.byName[entry.organization.Name] = entry
+		}
+		c.mu.Unlock()
+		close(entry.done)
+
+		return entry.organization, entry.err
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-entry.done:
+	}
+
+	return entry.organization, entry.err
+}
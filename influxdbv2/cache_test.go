@@ -0,0 +1,185 @@
+package influxdbv2
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func TestAuthorizationsCache(t *testing.T) {
+	cache := newAuthorizationsCache(time.Minute)
+
+	var calls int32
+	fetch := func(ctx context.Context) (*[]domain.Authorization, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		id := "0123456789abcdef"
+		return &[]domain.Authorization{{Id: &id}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			authorizations, err := cache.list(context.Background(), "org", fetch)
+			if err != nil || len(authorizations) != 1 {
+				t.Errorf("unexpected result: %v, %v", authorizations, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+
+	if _, err := cache.list(context.Background(), "other", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls after listing another organization, got %d", calls)
+	}
+
+	cache.invalidate("org")
+	if _, err := cache.list(context.Background(), "org", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls after invalidation, got %d", calls)
+	}
+}
+
+func TestAuthorizationsCache_Expiry(t *testing.T) {
+	cache := newAuthorizationsCache(0)
+
+	var calls int32
+	fetch := func(ctx context.Context) (*[]domain.Authorization, error) {
+		atomic.AddInt32(&calls, 1)
+		return &[]domain.Authorization{}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.list(context.Background(), "org", fetch); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestAuthorizationsCache_Error(t *testing.T) {
+	cache := newAuthorizationsCache(time.Minute)
+
+	var calls int32
+	fetch := func(ctx context.Context) (*[]domain.Authorization, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return &[]domain.Authorization{}, nil
+	}
+
+	if _, err := cache.list(context.Background(), "org", fetch); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := cache.list(context.Background(), "org", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected the failed listing to be retried, got %d calls", calls)
+	}
+}
+
+func TestOrganizationsCache(t *testing.T) {
+	cache := newOrganizationsCache()
+
+	var calls int32
+	fetch := func(name string) func(ctx context.Context) (*domain.Organization, error) {
+		return func(ctx context.Context) (*domain.Organization, error) {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(10 * time.Millisecond)
+			id := name + "-id"
+			return &domain.Organization{Id: &id, Name: name}, nil
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		for _, name := range []string{"first", "second"} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				org, err := cache.findByName(context.Background(), name, fetch(name))
+				if err != nil || org.Name != name {
+					t.Errorf("unexpected result: %v, %v", org, err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	if calls != 2 {
+		t.Errorf("expected 1 call per name, got %d", calls)
+	}
+
+	// Organizations resolved by name are also known by ID
+	if _, err := cache.findByID(context.Background(), "first-id", fetch("first")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected no call when looking up a known ID, got %d calls", calls)
+	}
+}
+
+func TestOrganizationsCache_Error(t *testing.T) {
+	cache := newOrganizationsCache()
+
+	var calls int32
+	fetch := func(ctx context.Context) (*domain.Organization, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, errors.New("organization not found")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.findByName(context.Background(), "missing", fetch); err == nil {
+			t.Fatal("expected an error")
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected failed lookups not to be reused, got %d calls", calls)
+	}
+}
+
+func TestOrganizationsCache_Disabled(t *testing.T) {
+	cache := newOrganizationsCache()
+	cache.disabled = true
+
+	var calls int32
+	fetch := func(ctx context.Context) (*domain.Organization, error) {
+		atomic.AddInt32(&calls, 1)
+		id := "94d518926178fea7"
+		return &domain.Organization{Id: &id, Name: "company"}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.findByName(context.Background(), "company", fetch); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err := cache.findByID(context.Background(), "94d518926178fea7", fetch); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if calls != 4 {
+		t.Errorf("expected every lookup to be fetched, got %d calls", calls)
+	}
+}
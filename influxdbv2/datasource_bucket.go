@@ -0,0 +1,355 @@
+package influxdbv2
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BucketDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &BucketDataSource{}
+
+func NewBucketDataSource() datasource.DataSource {
+	return &BucketDataSource{}
+}
+
+// BucketDataSource defines the data source implementation.
+type BucketDataSource struct {
+	client influxdb2.Client
+}
+
+// BucketDataSourceModel describes the data source data model.
+type BucketDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	OrgID            types.String `tfsdk:"org_id"`
+	Description      types.String `tfsdk:"description"`
+	Type             types.String `tfsdk:"type"`
+	RetentionSeconds types.Int64  `tfsdk:"retention_seconds"`
+	RP               types.String `tfsdk:"rp"`
+	SchemaType       types.String `tfsdk:"schema_type"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+	WithUsage        types.Bool   `tfsdk:"with_usage"`
+	SeriesCount      types.Int64  `tfsdk:"series_count"`
+	DiskUsageBytes   types.Int64  `tfsdk:"disk_usage_bytes"`
+}
+
+// Storage metrics of the OSS metrics endpoint, labelled with the bucket ID.
+const (
+	bucketSeriesMetric    = "storage_bucket_series_num"
+	bucketDiskUsageMetric = "storage_shard_disk_size"
+)
+
+func (d *BucketDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket"
+}
+
+func (d *BucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to look up a single bucket by ID or by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the bucket. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the bucket. Exactly one of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID of the bucket. When looking up by name, restricts the lookup to this organization.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the bucket.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the bucket, 'user' or 'system'.",
+				Computed:    true,
+			},
+			"retention_seconds": schema.Int64Attribute{
+				Description: "The retention period of the bucket in seconds, 0 for infinite retention.",
+				Computed:    true,
+			},
+			"rp": schema.StringAttribute{
+				Description: "The retention policy of the bucket.",
+				Computed:    true,
+			},
+			"schema_type": schema.StringAttribute{
+				Description: "The schema type of the bucket, 'implicit' or 'explicit'.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The RFC3339 timestamp when the bucket was created.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The RFC3339 timestamp when the bucket was last updated.",
+				Computed:    true,
+			},
+			"with_usage": schema.BoolAttribute{
+				Description: "Whether to read the series_count and disk_usage_bytes of the bucket from the server metrics.",
+				Optional:    true,
+			},
+			"series_count": schema.Int64Attribute{
+				Description: "The approximate number of series of the bucket. Only read when with_usage is set, null when the server does not report it.",
+				Computed:    true,
+			},
+			"disk_usage_bytes": schema.Int64Attribute{
+				Description: "The approximate disk usage of the bucket in bytes. Only read when with_usage is set, null when the server does not report it.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *BucketDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *BucketDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config BucketDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are checked once known
+	if config.ID.IsUnknown() || config.Name.IsUnknown() {
+		return
+	}
+
+	if config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Bucket Lookup",
+			"Exactly one of id or name must be set.",
+		)
+		return
+	}
+
+	if !config.ID.IsNull() && !config.OrgID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Invalid Bucket Lookup",
+			"org_id can only be set when looking up a bucket by name.",
+		)
+	}
+}
+
+func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BucketDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var bucket *domain.Bucket
+	var err error
+	if !state.ID.IsNull() {
+		tflog.Debug(ctx, "Finding bucket by ID", map[string]any{"id": state.ID.ValueString()})
+
+		bucket, err = d.client.BucketsAPI().FindBucketByID(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Bucket",
+				"Could not find bucket ID "+state.ID.ValueString()+": "+formatAPIError(err),
+			)
+			return
+		}
+	} else {
+		tflog.Debug(ctx, "Finding bucket by name", map[string]any{"name": state.Name.ValueString(), "org_id": state.OrgID.ValueString()})
+
+		bucket, err = d.findBucketByName(ctx, state.Name.ValueString(), state.OrgID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Bucket",
+				"Could not find bucket named "+state.Name.ValueString()+": "+formatAPIError(err),
+			)
+			return
+		}
+	}
+
+	state.ID = types.StringValue(*bucket.Id)
+	state.Name = types.StringValue(bucket.Name)
+	state.OrgID = types.StringValue("")
+	if bucket.OrgID != nil {
+		state.OrgID = types.StringValue(*bucket.OrgID)
+	}
+	state.Description = types.StringValue("")
+	if bucket.Description != nil {
+		state.Description = types.StringValue(*bucket.Description)
+	}
+	state.Type = types.StringValue("")
+	if bucket.Type != nil {
+		state.Type = types.StringValue(string(*bucket.Type))
+	}
+	state.RetentionSeconds = types.Int64Value(retentionSecondsFromDomain(bucket.RetentionRules))
+	state.RP = types.StringValue("")
+	if bucket.Rp != nil {
+		state.RP = types.StringValue(*bucket.Rp)
+	}
+	state.SchemaType = types.StringValue("")
+	if bucket.SchemaType != nil {
+		state.SchemaType = types.StringValue(string(*bucket.SchemaType))
+	}
+	state.CreatedAt = timestampValue(bucket.CreatedAt)
+	state.UpdatedAt = timestampValue(bucket.UpdatedAt)
+
+	state.SeriesCount = types.Int64Null()
+	state.DiskUsageBytes = types.Int64Null()
+	if state.WithUsage.ValueBool() {
+		usage, err := readBucketUsage(ctx, d.client, *bucket.Id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Bucket Usage",
+				"Could not read the usage of bucket ID "+*bucket.Id+": "+formatAPIError(err),
+			)
+			return
+		}
+		state.SeriesCount = usage.seriesCount
+		state.DiskUsageBytes = usage.diskUsageBytes
+	}
+
+	tflog.Trace(ctx, "Found bucket", map[string]any{"id": state.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper function to find a bucket by name, within an organization when one
+// is given
+func (d *BucketDataSource) findBucketByName(ctx context.Context, name, orgID string) (*domain.Bucket, error) {
+	if orgID == "" {
+		return d.client.BucketsAPI().FindBucketByName(ctx, name)
+	}
+
+	buckets, err := findAllBuckets(ctx, d.client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range buckets {
+		if buckets[i].Name == name {
+			return &buckets[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("bucket not found in organization ID %s", orgID)
+}
+
+// bucketUsage is the storage usage of a bucket, null when not reported.
+type bucketUsage struct {
+	seriesCount    types.Int64
+	diskUsageBytes types.Int64
+}
+
+// Helper function to read the storage usage of a bucket from the Prometheus
+// metrics endpoint of InfluxDB OSS. InfluxDB Cloud does not expose it, and the
+// usage is then null.
+func readBucketUsage(ctx context.Context, client influxdb2.Client, bucketID string) (*bucketUsage, error) {
+	usage := &bucketUsage{
+		seriesCount:    types.Int64Null(),
+		diskUsageBytes: types.Int64Null(),
+	}
+
+	service := client.HTTPService()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, service.ServerURL()+"metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if herr := service.DoHTTPRequest(req, nil, func(resp *http.Response) error {
+		defer resp.Body.Close()
+		return parseBucketUsage(bufio.NewScanner(resp.Body), bucketID, usage)
+	}); herr != nil {
+		if isNotFoundError(herr) {
+			tflog.Debug(ctx, "InfluxDB server does not expose the metrics endpoint")
+			return usage, nil
+		}
+		return nil, herr
+	}
+
+	return usage, nil
+}
+
+// Helper function to sum the storage metrics of a bucket from the Prometheus
+// text format. The disk usage is reported per shard.
+func parseBucketUsage(scanner *bufio.Scanner, bucketID string, usage *bucketUsage) error {
+	bucketLabel := `bucket="` + bucketID + `"`
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, rest, found := strings.Cut(line, "{")
+		if !found {
+			continue
+		}
+		labels, sample, found := strings.Cut(rest, "}")
+		if !found || !containsLabel(labels, bucketLabel) {
+			continue
+		}
+		fields := strings.Fields(sample)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return fmt.Errorf("invalid value of metric %s: %w", name, err)
+		}
+
+		switch name {
+		case bucketSeriesMetric:
+			usage.seriesCount = types.Int64Value(usage.seriesCount.ValueInt64() + int64(value))
+		case bucketDiskUsageMetric:
+			usage.diskUsageBytes = types.Int64Value(usage.diskUsageBytes.ValueInt64() + int64(value))
+		}
+	}
+
+	return scanner.Err()
+}
+
+// Helper function to check if a comma-separated list of Prometheus labels
+// contains the given label
+func containsLabel(labels, label string) bool {
+	for _, candidate := range strings.Split(labels, ",") {
+		if candidate == label {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestAccBucketDataSource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Lookup by ID
+					resource.TestCheckResourceAttrPair("data.influxdb-v2_bucket.by_id", "name", "influxdb-v2_bucket.test", "name"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_bucket.by_id", "org_id", orgID),
+					resource.TestCheckResourceAttr("data.influxdb-v2_bucket.by_id", "description", "Looked up bucket"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_bucket.by_id", "retention_seconds", "3600"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_bucket.by_id", "type", "user"),
+					// Lookup by name
+					resource.TestCheckResourceAttrPair("data.influxdb-v2_bucket.by_name", "id", "influxdb-v2_bucket.test", "id"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_bucket.by_name", "retention_seconds", "3600"),
+					// Lookup by name within an organization
+					resource.TestCheckResourceAttrPair("data.influxdb-v2_bucket.by_org_name", "id", "influxdb-v2_bucket.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestReadBucketUsage(t *testing.T) {
+	const metrics = `# HELP storage_bucket_series_num Gauge of series cardinality per bucket
+# TYPE storage_bucket_series_num gauge
+storage_bucket_series_num{bucket="0000000000000001"} 42
+storage_bucket_series_num{bucket="0000000000000002"} 7
+# HELP storage_shard_disk_size Gauge of the disk size for the shard
+# TYPE storage_shard_disk_size gauge
+storage_shard_disk_size{bucket="0000000000000001",engine="tsm1",id="1",path="/data/1",walPath="/wal/1"} 1024
+storage_shard_disk_size{bucket="0000000000000001",engine="tsm1",id="2",path="/data/2",walPath="/wal/2"} 2048
+storage_shard_disk_size{bucket="0000000000000002",engine="tsm1",id="3",path="/data/3",walPath="/wal/3"} 4096
+`
+
+	tests := map[string]struct {
+		bucketID       string
+		status         int
+		seriesCount    types.Int64
+		diskUsageBytes types.Int64
+	}{
+		"reported": {
+			bucketID:       "0000000000000001",
+			status:         http.StatusOK,
+			seriesCount:    types.Int64Value(42),
+			diskUsageBytes: types.Int64Value(3072),
+		},
+		"not reported": {
+			bucketID:       "0000000000000003",
+			status:         http.StatusOK,
+			seriesCount:    types.Int64Null(),
+			diskUsageBytes: types.Int64Null(),
+		},
+		"no metrics endpoint": {
+			bucketID:       "0000000000000001",
+			status:         http.StatusNotFound,
+			seriesCount:    types.Int64Null(),
+			diskUsageBytes: types.Int64Null(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/metrics" || test.status != http.StatusOK {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, metrics)
+			}))
+			defer server.Close()
+
+			client := influxdb2.NewClient(server.URL, "token")
+			defer client.Close()
+
+			usage, err := readBucketUsage(context.Background(), client, test.bucketID)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !usage.seriesCount.Equal(test.seriesCount) {
+				t.Errorf("expected series count %s, got %s", test.seriesCount, usage.seriesCount)
+			}
+			if !usage.diskUsageBytes.Equal(test.diskUsageBytes) {
+				t.Errorf("expected disk usage %s, got %s", test.diskUsageBytes, usage.diskUsageBytes)
+			}
+		})
+	}
+}
+
+func TestAccBucketDataSource_InvalidLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `data "influxdb-v2_bucket" "test" {}`,
+				ExpectError: regexp.MustCompile(`Exactly one of id or name must be set`),
+			},
+			{
+				Config: `
+data "influxdb-v2_bucket" "test" {
+  id   = "0b5e7f9c3a2d4680"
+  name = "telegraf"
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of id or name must be set`),
+			},
+		},
+	})
+}
+
+func testAccBucketDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name              = "test-bucket-datasource"
+  description       = "Looked up bucket"
+  org_id            = %[1]q
+  retention_seconds = 3600
+}
+
+data "influxdb-v2_bucket" "by_id" {
+  id = influxdb-v2_bucket.test.id
+}
+
+data "influxdb-v2_bucket" "by_name" {
+  name = influxdb-v2_bucket.test.name
+}
+
+data "influxdb-v2_bucket" "by_org_name" {
+  name   = influxdb-v2_bucket.test.name
+  org_id = %[1]q
+}
+`, orgID)
+}
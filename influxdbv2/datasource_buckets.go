@@ -0,0 +1,247 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// bucketsPageSize is the number of buckets requested per page when listing.
+const bucketsPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BucketsDataSource{}
+
+func NewBucketsDataSource() datasource.DataSource {
+	return &BucketsDataSource{}
+}
+
+// BucketsDataSource defines the data source implementation.
+type BucketsDataSource struct {
+	client influxdb2.Client
+}
+
+// BucketsDataSourceModel describes the data source data model.
+type BucketsDataSourceModel struct {
+	ID            types.String           `tfsdk:"id"`
+	OrgID         types.String           `tfsdk:"org_id"`
+	LabelName     types.String           `tfsdk:"label_name"`
+	IncludeSystem types.Bool             `tfsdk:"include_system"`
+	Buckets       []BucketsDataItemModel `tfsdk:"buckets"`
+}
+
+// BucketsDataItemModel describes a single bucket returned by the data source.
+type BucketsDataItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	OrgID       types.String `tfsdk:"org_id"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (d *BucketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_buckets"
+}
+
+func (d *BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to list the buckets of an organization, optionally filtered by label.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (organization ID).",
+				Computed:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID to list buckets for.",
+				Required:    true,
+			},
+			"label_name": schema.StringAttribute{
+				Description: "Only return buckets carrying a label with this name.",
+				Optional:    true,
+			},
+			"include_system": schema.BoolAttribute{
+				Description: "Also return system buckets such as '_monitoring' and '_tasks'. Defaults to false.",
+				Optional:    true,
+			},
+			"buckets": schema.ListNestedAttribute{
+				Description: "The matching buckets.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the bucket.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the bucket.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the bucket.",
+							Computed:    true,
+						},
+						"org_id": schema.StringAttribute{
+							Description: "The organization ID.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the bucket.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state BucketsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+
+	tflog.Debug(ctx, "Listing buckets", map[string]any{
+		"org_id":         orgID,
+		"label_name":     state.LabelName.ValueString(),
+		"include_system": state.IncludeSystem.ValueBool(),
+	})
+
+	buckets, err := findAllBuckets(ctx, d.client, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Buckets",
+			"Could not list buckets for organization ID "+orgID+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(orgID)
+	state.Buckets = []BucketsDataItemModel{}
+
+	for _, bucket := range buckets {
+		if isSystemBucket(bucket) && !state.IncludeSystem.ValueBool() {
+			continue
+		}
+
+		if !state.LabelName.IsNull() {
+			hasLabel, err := d.bucketHasLabel(ctx, *bucket.Id, state.LabelName.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Bucket Labels",
+					"Could not read labels of bucket ID "+*bucket.Id+": "+formatAPIError(err),
+				)
+				return
+			}
+			if !hasLabel {
+				continue
+			}
+		}
+
+		state.Buckets = append(state.Buckets, bucketsDataItemFromDomain(bucket))
+	}
+
+	tflog.Trace(ctx, "Listed buckets", map[string]any{"org_id": orgID, "count": len(state.Buckets)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper function to page through all buckets of an organization
+func findAllBuckets(ctx context.Context, client influxdb2.Client, orgID string) ([]domain.Bucket, error) {
+	buckets := []domain.Bucket{}
+	for offset := 0; ; offset += bucketsPageSize {
+		page, err := client.BucketsAPI().FindBucketsByOrgID(ctx, orgID, api.PagingWithOffset(offset), api.PagingWithLimit(bucketsPageSize))
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			break
+		}
+
+		buckets = append(buckets, *page...)
+		if len(*page) < bucketsPageSize {
+			break
+		}
+	}
+
+	return buckets, nil
+}
+
+// Helper function to check whether a bucket is one of the buckets InfluxDB
+// creates for its own use, such as _monitoring and _tasks
+func isSystemBucket(bucket domain.Bucket) bool {
+	return bucket.Type != nil && *bucket.Type == domain.BucketTypeSystem
+}
+
+// Helper function to check whether a bucket carries a label with the given name.
+// The bucket labels endpoint returns every label in a single response.
+func (d *BucketsDataSource) bucketHasLabel(ctx context.Context, bucketID, labelName string) (bool, error) {
+	result, err := d.client.APIClient().GetBucketsIDLabels(ctx, &domain.GetBucketsIDLabelsAllParams{BucketID: bucketID})
+	if err != nil {
+		return false, err
+	}
+
+	if result.Labels == nil {
+		return false, nil
+	}
+
+	for _, label := range *result.Labels {
+		if label.Name != nil && *label.Name == labelName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Helper function to convert a domain bucket into the data source item model
+func bucketsDataItemFromDomain(bucket domain.Bucket) BucketsDataItemModel {
+	item := BucketsDataItemModel{
+		ID:          types.StringValue(*bucket.Id),
+		Name:        types.StringValue(bucket.Name),
+		Description: types.StringValue(""),
+		OrgID:       types.StringValue(""),
+		Type:        types.StringValue(""),
+	}
+
+	if bucket.Description != nil {
+		item.Description = types.StringValue(*bucket.Description)
+	}
+	if bucket.OrgID != nil {
+		item.OrgID = types.StringValue(*bucket.OrgID)
+	}
+	if bucket.Type != nil {
+		item.Type = types.StringValue(string(*bucket.Type))
+	}
+
+	return item
+}
@@ -0,0 +1,153 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func TestAccBucketsDataSource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketsDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb-v2_buckets.test", "id", orgID),
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_buckets.test", "buckets.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb-v2_buckets.test", "buckets.*", map[string]string{
+						"name": "test-buckets-datasource",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketsDataSource_LabelName(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketsDataSourceConfigLabel(orgID, "label-that-does-not-exist"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb-v2_buckets.test", "buckets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketsDataSource_IncludeSystem(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// System buckets are excluded by default
+			{
+				Config: testAccBucketsDataSourceConfigIncludeSystem(orgID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketsDataSourceExcludes("data.influxdb-v2_buckets.test", "_monitoring"),
+					testAccCheckBucketsDataSourceExcludes("data.influxdb-v2_buckets.test", "_tasks"),
+				),
+			},
+			{
+				Config: testAccBucketsDataSourceConfigIncludeSystem(orgID, "include_system = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb-v2_buckets.test", "buckets.*", map[string]string{
+						"name": "_monitoring",
+						"type": "system",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestIsSystemBucket(t *testing.T) {
+	system := domain.BucketTypeSystem
+	user := domain.BucketTypeUser
+
+	tests := []struct {
+		name     string
+		bucket   domain.Bucket
+		expected bool
+	}{
+		{name: "system", bucket: domain.Bucket{Name: "_monitoring", Type: &system}, expected: true},
+		{name: "user", bucket: domain.Bucket{Name: "metrics", Type: &user}, expected: false},
+		{name: "no type", bucket: domain.Bucket{Name: "metrics"}, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := isSystemBucket(test.bucket); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+// Helper function to check that no listed bucket has the given name
+func testAccCheckBucketsDataSourceExcludes(resourceName, bucketName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "buckets.") && strings.HasSuffix(key, ".name") && value == bucketName {
+				return fmt.Errorf("bucket %s should not be listed", bucketName)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketsDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name              = "test-buckets-datasource"
+  org_id            = %[1]q
+  retention_seconds = 3600
+}
+
+data "influxdb-v2_buckets" "test" {
+  org_id = influxdb-v2_bucket.test.org_id
+
+  depends_on = [influxdb-v2_bucket.test]
+}
+`, orgID)
+}
+
+func testAccBucketsDataSourceConfigLabel(orgID, labelName string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_buckets" "test" {
+  org_id     = %[1]q
+  label_name = %[2]q
+}
+`, orgID, labelName)
+}
+
+func testAccBucketsDataSourceConfigIncludeSystem(orgID, includeSystem string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_buckets" "test" {
+  org_id = %[1]q
+  %[2]s
+}
+`, orgID, includeSystem)
+}
@@ -0,0 +1,144 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CheckDataSource{}
+
+func NewCheckDataSource() datasource.DataSource {
+	return &CheckDataSource{}
+}
+
+// CheckDataSource defines the data source implementation.
+type CheckDataSource struct {
+	client influxdb2.Client
+}
+
+// CheckDataSourceModel describes the data source data model.
+type CheckDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	OrgID  types.String `tfsdk:"org_id"`
+	Type   types.String `tfsdk:"type"`
+	Status types.String `tfsdk:"status"`
+	Query  types.String `tfsdk:"query"`
+}
+
+// checkSummary holds the fields common to every check type. The generated
+// client decodes checks into a different struct per type.
+type checkSummary struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	OrgID  string `json:"orgID"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+func (d *CheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check"
+}
+
+func (d *CheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to read a check and the Flux query it runs, for example to debug a check created in the UI.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the check.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the check.",
+				Computed:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID of the check.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the check, such as 'threshold', 'deadman' or 'custom'.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the check, 'active' or 'inactive'.",
+				Computed:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "The Flux query generated for the check, empty when the server does not expose one.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *CheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state CheckDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkID := state.ID.ValueString()
+
+	tflog.Debug(ctx, "Reading check", map[string]any{"id": checkID})
+
+	var check checkSummary
+	if err := doAPIRequest(ctx, d.client, http.MethodGet, "checks/"+checkID, nil, &check); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Check",
+			"Could not read check ID "+checkID+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(check.Name)
+	state.OrgID = types.StringValue(check.OrgID)
+	state.Type = types.StringValue(check.Type)
+	state.Status = types.StringValue(check.Status)
+	state.Query = types.StringValue("")
+
+	// The check exists, so a failure to build its query is reported as a
+	// warning rather than failing the whole read.
+	result, err := d.client.APIClient().GetChecksIDQuery(ctx, &domain.GetChecksIDQueryAllParams{CheckID: checkID})
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Check Query Unavailable",
+			"Could not get the Flux query of check ID "+checkID+", query is left empty: "+formatAPIError(err),
+		)
+	} else if result.Flux != nil {
+		state.Query = types.StringValue(*result.Flux)
+	}
+
+	tflog.Trace(ctx, "Read check", map[string]any{"id": checkID, "type": check.Type})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,117 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestAccCheckDataSource_UnknownCheck(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDataSourceConfig("000000000000000a"),
+				ExpectError: regexp.MustCompile(`Error Reading Check`),
+			},
+		},
+	})
+}
+
+func TestCheckDataSource_Read(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/checks/0000000000000001", "/api/v2/checks/0000000000000002":
+			id := r.URL.Path[len("/api/v2/checks/"):]
+			fmt.Fprintf(w, `{"id":%q,"name":"cpu","orgID":"94d518926178fea7","type":"threshold","status":"active"}`, id)
+		case "/api/v2/checks/0000000000000001/query":
+			fmt.Fprint(w, `{"flux":"from(bucket: \"telegraf\")"}`)
+		case "/api/v2/checks/0000000000000002/query":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"code":"internal error","message":"could not build the check query"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	tests := map[string]struct {
+		checkID string
+		query   string
+		warning bool
+	}{
+		"with query": {
+			checkID: "0000000000000001",
+			query:   `from(bucket: "telegraf")`,
+		},
+		"query unavailable": {
+			checkID: "0000000000000002",
+			query:   "",
+			warning: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			d := &CheckDataSource{client: client}
+
+			var schemaResp fwdatasource.SchemaResponse
+			d.Schema(ctx, fwdatasource.SchemaRequest{}, &schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["id"] = tftypes.NewValue(tftypes.String, test.checkID)
+
+			req := fwdatasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}
+			resp := fwdatasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			d.Read(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if warned := resp.Diagnostics.WarningsCount() == 1; warned != test.warning {
+				t.Errorf("expected warning %t, got: %v", test.warning, resp.Diagnostics)
+			}
+
+			var state CheckDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.Name.ValueString() != "cpu" || state.Type.ValueString() != "threshold" {
+				t.Errorf("expected the check to be read, got name %s and type %s", state.Name, state.Type)
+			}
+			if state.Query.ValueString() != test.query {
+				t.Errorf("expected query %q, got %q", test.query, state.Query.ValueString())
+			}
+		})
+	}
+}
+
+func testAccCheckDataSourceConfig(checkID string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_check" "test" {
+  id = %[1]q
+}
+`, checkID)
+}
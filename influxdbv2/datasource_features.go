@@ -0,0 +1,188 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// serverFeatures lists the features reported by the features data source,
+// with the edition and the first server version supporting them.
+var serverFeatures = []serverFeature{
+	{
+		attribute:   "supports_explicit_schema",
+		description: "Whether buckets with an explicit schema can be created.",
+		edition:     editionCloud,
+	},
+	{
+		attribute:   "supports_replication",
+		description: "Whether replication streams to remote InfluxDB instances are available.",
+		edition:     editionOSS,
+		minVersion:  serverVersion{2, 2, 0},
+	},
+}
+
+// serverFeature is a feature only available on an edition of InfluxDB, from
+// a server version when minVersion is set.
+type serverFeature struct {
+	attribute   string
+	description string
+	edition     string
+	minVersion  serverVersion
+}
+
+// Helper function reporting whether the feature is supported by a server.
+// Features are unsupported when the edition could not be detected, or when
+// the version is needed but could not be parsed.
+func (f serverFeature) supported(edition string, version serverVersion, versionOK bool) bool {
+	if edition != f.edition {
+		return false
+	}
+	if f.minVersion == (serverVersion{}) {
+		return true
+	}
+
+	return versionOK && version.atLeast(f.minVersion)
+}
+
+// serverVersionPattern matches the semantic version reported by the health
+// endpoint, such as "v2.7.4" or "2.7.4-rc1".
+var serverVersionPattern = regexp.MustCompile(`(\d+)\.(\d+)\.(\d+)`)
+
+// serverVersion is a major, minor and patch version number.
+type serverVersion [3]int
+
+// Helper function to parse a server version, reporting whether it was found
+func parseServerVersion(value string) (serverVersion, bool) {
+	match := serverVersionPattern.FindStringSubmatch(value)
+	if match == nil {
+		return serverVersion{}, false
+	}
+
+	var version serverVersion
+	for i := range version {
+		number, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return serverVersion{}, false
+		}
+		version[i] = number
+	}
+
+	return version, true
+}
+
+// Helper function reporting whether the version is at least the given one
+func (v serverVersion) atLeast(other serverVersion) bool {
+	for i := range v {
+		if v[i] != other[i] {
+			return v[i] > other[i]
+		}
+	}
+
+	return true
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FeaturesDataSource{}
+
+func NewFeaturesDataSource() datasource.DataSource {
+	return &FeaturesDataSource{}
+}
+
+// FeaturesDataSource defines the data source implementation.
+type FeaturesDataSource struct {
+	client  influxdb2.Client
+	edition string
+}
+
+func (d *FeaturesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_features"
+}
+
+func (d *FeaturesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Data source identifier (server URL).",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "The version reported by the server.",
+			Computed:    true,
+		},
+		"edition": schema.StringAttribute{
+			Description: "The edition of the server, 'oss' or 'cloud', empty when it could not be detected.",
+			Computed:    true,
+		},
+	}
+	for _, feature := range serverFeatures {
+		attributes[feature.attribute] = schema.BoolAttribute{
+			Description: feature.description,
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Data source returning the features supported by the InfluxDB server, derived from its version.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *FeaturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.edition = providerData.edition
+}
+
+func (d *FeaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	health, err := d.client.Health(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Server Version",
+			"Could not read the server health: "+formatAPIError(err),
+		)
+		return
+	}
+
+	versionString := ""
+	if health.Version != nil {
+		versionString = *health.Version
+	}
+
+	version, ok := parseServerVersion(versionString)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unknown Server Version",
+			"Could not parse the server version "+strconv.Quote(versionString)+", version-gated features are reported as unsupported.",
+		)
+	}
+
+	tflog.Debug(ctx, "Read server version", map[string]any{"version": versionString})
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(d.client.ServerURL()))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), types.StringValue(versionString))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edition"), types.StringValue(d.edition))...)
+	for _, feature := range serverFeatures {
+		supported := feature.supported(d.edition, version, ok)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(feature.attribute), types.BoolValue(supported))...)
+	}
+}
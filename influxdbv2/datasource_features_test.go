@@ -0,0 +1,103 @@
+package influxdbv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeaturesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccFeaturesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_features.test", "id"),
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_features.test", "version"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_features.test", "edition", "oss"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_features.test", "supports_explicit_schema", "false"),
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_features.test", "supports_replication"),
+				),
+			},
+		},
+	})
+}
+
+func TestServerVersion(t *testing.T) {
+	tests := []struct {
+		value   string
+		ok      bool
+		atLeast bool
+	}{
+		{value: "v2.7.4", ok: true, atLeast: true},
+		{value: "2.2.0", ok: true, atLeast: true},
+		{value: "v2.1.1", ok: true, atLeast: false},
+		{value: "v2.0.9-rc1", ok: true, atLeast: false},
+		{value: "v10.0.0", ok: true, atLeast: true},
+		{value: "dev", ok: false},
+		{value: "", ok: false},
+	}
+
+	for _, test := range tests {
+		version, ok := parseServerVersion(test.value)
+		if ok != test.ok {
+			t.Errorf("parseServerVersion(%q) ok = %t; expected %t", test.value, ok, test.ok)
+			continue
+		}
+		if ok && version.atLeast(serverVersion{2, 2, 0}) != test.atLeast {
+			t.Errorf("%q atLeast 2.2.0 = %t; expected %t", test.value, !test.atLeast, test.atLeast)
+		}
+	}
+}
+
+func TestServerFeatures(t *testing.T) {
+	tests := map[string]struct {
+		edition  string
+		version  string
+		expected map[string]bool
+	}{
+		"oss": {
+			edition:  editionOSS,
+			version:  "v2.7.4",
+			expected: map[string]bool{"supports_explicit_schema": false, "supports_replication": true},
+		},
+		"old oss": {
+			edition:  editionOSS,
+			version:  "v2.1.1",
+			expected: map[string]bool{"supports_explicit_schema": false, "supports_replication": false},
+		},
+		"cloud": {
+			edition:  editionCloud,
+			version:  "v2.7.4",
+			expected: map[string]bool{"supports_explicit_schema": true, "supports_replication": false},
+		},
+		"cloud without version": {
+			edition:  editionCloud,
+			version:  "dev",
+			expected: map[string]bool{"supports_explicit_schema": true, "supports_replication": false},
+		},
+		"unknown edition": {
+			edition:  "",
+			version:  "v2.7.4",
+			expected: map[string]bool{"supports_explicit_schema": false, "supports_replication": false},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			version, ok := parseServerVersion(test.version)
+			for _, feature := range serverFeatures {
+				if supported := feature.supported(test.edition, version, ok); supported != test.expected[feature.attribute] {
+					t.Errorf("expected %s to be %t, got %t", feature.attribute, test.expected[feature.attribute], supported)
+				}
+			}
+		})
+	}
+}
+
+const testAccFeaturesDataSourceConfig = `
+data "influxdb-v2_features" "test" {}
+`
@@ -0,0 +1,170 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// Resource types enumerated by the import blocks data source.
+const (
+	importResourceTypeBucket        = "bucket"
+	importResourceTypeAuthorization = "authorization"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImportBlocksDataSource{}
+
+func NewImportBlocksDataSource() datasource.DataSource {
+	return &ImportBlocksDataSource{}
+}
+
+// ImportBlocksDataSource defines the data source implementation.
+type ImportBlocksDataSource struct {
+	client influxdb2.Client
+}
+
+// ImportBlocksDataSourceModel describes the data source data model.
+type ImportBlocksDataSourceModel struct {
+	ID           types.String           `tfsdk:"id"`
+	OrgID        types.String           `tfsdk:"org_id"`
+	ResourceType types.String           `tfsdk:"resource_type"`
+	Resources    []ImportBlockItemModel `tfsdk:"resources"`
+}
+
+// ImportBlockItemModel describes an existing object and its import ID.
+type ImportBlockItemModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *ImportBlocksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_blocks"
+}
+
+func (d *ImportBlocksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source enumerating the existing objects of an organization with their import IDs, " +
+			"to generate import blocks when adopting an existing InfluxDB.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (organization ID and resource type).",
+				Computed:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID to enumerate objects of.",
+				Required:    true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "The type of objects to enumerate, 'bucket' or 'authorization'.",
+				Required:    true,
+			},
+			"resources": schema.ListNestedAttribute{
+				Description: "The existing objects.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The import ID of the object.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the object, the description for authorizations.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ImportBlocksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *ImportBlocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ImportBlocksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	resourceType := state.ResourceType.ValueString()
+
+	tflog.Debug(ctx, "Enumerating objects to import", map[string]any{"org_id": orgID, "resource_type": resourceType})
+
+	state.Resources = []ImportBlockItemModel{}
+	switch resourceType {
+	case importResourceTypeBucket:
+		buckets, err := findAllBuckets(ctx, d.client, orgID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Buckets",
+				"Could not list buckets for organization ID "+orgID+": "+formatAPIError(err),
+			)
+			return
+		}
+		for _, bucket := range buckets {
+			state.Resources = append(state.Resources, ImportBlockItemModel{
+				ID:   types.StringValue(*bucket.Id),
+				Name: types.StringValue(bucket.Name),
+			})
+		}
+	case importResourceTypeAuthorization:
+		// The authorizations endpoint is not paginated, it returns every match.
+		authorizations, err := d.client.AuthorizationsAPI().FindAuthorizationsByOrgID(ctx, orgID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Listing Authorizations",
+				"Could not list authorizations for organization ID "+orgID+": "+formatAPIError(err),
+			)
+			return
+		}
+		for _, authorization := range *authorizations {
+			name := ""
+			if authorization.Description != nil {
+				name = *authorization.Description
+			}
+			state.Resources = append(state.Resources, ImportBlockItemModel{
+				ID:   types.StringValue(*authorization.Id),
+				Name: types.StringValue(name),
+			})
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource_type"),
+			"Invalid Resource Type",
+			"The resource_type must be either 'bucket' or 'authorization', got: "+resourceType,
+		)
+		return
+	}
+
+	state.ID = types.StringValue(orgID + "/" + resourceType)
+
+	tflog.Trace(ctx, "Enumerated objects to import", map[string]any{"org_id": orgID, "count": len(state.Resources)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,69 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImportBlocksDataSource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccImportBlocksDataSourceConfig(orgID, "bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb-v2_import_blocks.test", "id", orgID+"/bucket"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb-v2_import_blocks.test", "resources.*", map[string]string{
+						"name": "test-import-blocks-bucket",
+					}),
+				),
+			},
+			{
+				Config: testAccImportBlocksDataSourceConfig(orgID, "authorization"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb-v2_import_blocks.test", "id", orgID+"/authorization"),
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_import_blocks.test", "resources.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImportBlocksDataSource_InvalidResourceType(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccImportBlocksDataSourceConfig(orgID, "dashboard"),
+				ExpectError: regexp.MustCompile(`Invalid Resource Type`),
+			},
+		},
+	})
+}
+
+func testAccImportBlocksDataSourceConfig(orgID, resourceType string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name   = "test-import-blocks-bucket"
+  org_id = %[1]q
+}
+
+data "influxdb-v2_import_blocks" "test" {
+  org_id        = %[1]q
+  resource_type = %[2]q
+
+  depends_on = [influxdb-v2_bucket.test]
+}
+`, orgID, resourceType)
+}
@@ -0,0 +1,164 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MeasurementSchemasDataSource{}
+
+func NewMeasurementSchemasDataSource() datasource.DataSource {
+	return &MeasurementSchemasDataSource{}
+}
+
+// MeasurementSchemasDataSource defines the data source implementation.
+type MeasurementSchemasDataSource struct {
+	client influxdb2.Client
+}
+
+// MeasurementSchemasDataSourceModel describes the data source data model.
+type MeasurementSchemasDataSourceModel struct {
+	ID                 types.String             `tfsdk:"id"`
+	BucketID           types.String             `tfsdk:"bucket_id"`
+	MeasurementSchemas []MeasurementSchemaModel `tfsdk:"measurement_schemas"`
+}
+
+func (d *MeasurementSchemasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_measurement_schemas"
+}
+
+func (d *MeasurementSchemasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to list the measurement schemas of a bucket with an explicit schema.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (bucket ID).",
+				Computed:    true,
+			},
+			"bucket_id": schema.StringAttribute{
+				Description: "The ID of the bucket to list measurement schemas for.",
+				Required:    true,
+			},
+			"measurement_schemas": schema.ListNestedAttribute{
+				Description: "The measurement schemas of the bucket, empty for a bucket with an implicit schema.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the measurement.",
+							Computed:    true,
+						},
+						"columns": schema.ListNestedAttribute{
+							Description: "The columns of the measurement.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the column.",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "The type of the column, 'timestamp', 'tag' or 'field'.",
+										Computed:    true,
+									},
+									"data_type": schema.StringAttribute{
+										Description: "The data type of a field column, null for other columns.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MeasurementSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *MeasurementSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state MeasurementSchemasDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucketID := state.BucketID.ValueString()
+
+	tflog.Debug(ctx, "Listing measurement schemas", map[string]any{"bucket_id": bucketID})
+
+	bucket, err := d.client.BucketsAPI().FindBucketByID(ctx, bucketID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Bucket",
+			"Could not read bucket ID "+bucketID+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(bucketID)
+	state.MeasurementSchemas = []MeasurementSchemaModel{}
+
+	// Only buckets with an explicit schema have measurement schemas, the
+	// endpoint rejects the others.
+	if bucket.SchemaType == nil || *bucket.SchemaType != domain.SchemaTypeExplicit {
+		tflog.Debug(ctx, "Bucket has no explicit schema", map[string]any{"bucket_id": bucketID})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	schemas, err := findMeasurementSchemas(ctx, d.client, bucketID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Measurement Schemas",
+			"Could not list measurement schemas of bucket ID "+bucketID+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	for _, measurement := range schemas {
+		schemaModel := MeasurementSchemaModel{Name: types.StringValue(measurement.Name), Columns: []MeasurementSchemaColumnModel{}}
+		for _, column := range measurement.Columns {
+			dataType := types.StringNull()
+			if column.DataType != "" {
+				dataType = types.StringValue(column.DataType)
+			}
+			schemaModel.Columns = append(schemaModel.Columns, MeasurementSchemaColumnModel{
+				Name:     types.StringValue(column.Name),
+				Type:     types.StringValue(column.Type),
+				DataType: dataType,
+			})
+		}
+		state.MeasurementSchemas = append(state.MeasurementSchemas, schemaModel)
+	}
+
+	tflog.Trace(ctx, "Listed measurement schemas", map[string]any{"bucket_id": bucketID, "count": len(state.MeasurementSchemas)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,41 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMeasurementSchemasDataSource_ImplicitBucket(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeasurementSchemasDataSourceConfig(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.influxdb-v2_measurement_schemas.test", "id", "influxdb-v2_bucket.test", "id"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_measurement_schemas.test", "measurement_schemas.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMeasurementSchemasDataSourceConfig(orgID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name        = "test-measurement-schemas-datasource"
+  org_id      = %[1]q
+  schema_type = "implicit"
+}
+
+data "influxdb-v2_measurement_schemas" "test" {
+  bucket_id = influxdb-v2_bucket.test.id
+}
+`, orgID)
+}
@@ -0,0 +1,107 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OnboardingDataSource{}
+
+func NewOnboardingDataSource() datasource.DataSource {
+	return &OnboardingDataSource{}
+}
+
+// OnboardingDataSource defines the data source implementation.
+type OnboardingDataSource struct {
+	client influxdb2.Client
+}
+
+// OnboardingDataSourceModel describes the data source data model.
+type OnboardingDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Allowed        types.Bool   `tfsdk:"allowed"`
+	SetupAvailable types.Bool   `tfsdk:"setup_available"`
+}
+
+func (d *OnboardingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_onboarding"
+}
+
+func (d *OnboardingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source returning whether the InfluxDB server still allows its initial setup.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (server URL).",
+				Computed:    true,
+			},
+			"allowed": schema.BoolAttribute{
+				Description: "Whether the initial setup is allowed, which is the case until the server is onboarded.",
+				Computed:    true,
+			},
+			"setup_available": schema.BoolAttribute{
+				Description: "Whether the server exposes the setup endpoint. InfluxDB Cloud does not, and is reported as not allowed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *OnboardingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *OnboardingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OnboardingDataSourceModel
+
+	tflog.Debug(ctx, "Checking if InfluxDB server allows setup")
+
+	state.ID = types.StringValue(d.client.ServerURL())
+	state.Allowed = types.BoolValue(false)
+	state.SetupAvailable = types.BoolValue(true)
+
+	result, err := d.client.APIClient().GetSetup(ctx, &domain.GetSetupParams{})
+	switch {
+	case isNotFoundError(err):
+		// The setup endpoint is disabled, there is nothing to onboard
+		tflog.Debug(ctx, "InfluxDB server does not expose the setup endpoint")
+		state.SetupAvailable = types.BoolValue(false)
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error Reading Onboarding Status",
+			"Could not check if the server allows setup: "+formatAPIError(err),
+		)
+		return
+	case result.Allowed != nil:
+		state.Allowed = types.BoolValue(*result.Allowed)
+	}
+
+	tflog.Trace(ctx, "InfluxDB onboarding status read", map[string]any{
+		"allowed":         state.Allowed.ValueBool(),
+		"setup_available": state.SetupAvailable.ValueBool(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
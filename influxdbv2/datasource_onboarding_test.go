@@ -0,0 +1,29 @@
+package influxdbv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOnboardingDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The test instance is already onboarded
+			{
+				Config: testAccOnboardingDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_onboarding.test", "id"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_onboarding.test", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_onboarding.test", "setup_available", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOnboardingDataSourceConfig = `
+data "influxdb-v2_onboarding" "test" {}
+`
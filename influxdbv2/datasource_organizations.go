@@ -0,0 +1,184 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// organizationsPageSize is the number of organizations requested per page when listing.
+const organizationsPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationsDataSource{}
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &OrganizationsDataSource{}
+}
+
+// OrganizationsDataSource defines the data source implementation.
+type OrganizationsDataSource struct {
+	client influxdb2.Client
+}
+
+// OrganizationsDataSourceModel describes the data source data model.
+type OrganizationsDataSourceModel struct {
+	ID            types.String                 `tfsdk:"id"`
+	NamePrefix    types.String                 `tfsdk:"name_prefix"`
+	Organizations []OrganizationsDataItemModel `tfsdk:"organizations"`
+}
+
+// OrganizationsDataItemModel describes a single organization returned by the data source.
+type OrganizationsDataItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+func (d *OrganizationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+func (d *OrganizationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to list the organizations visible to the provider token, optionally filtered by name prefix.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (always 'organizations').",
+				Computed:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return organizations whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"organizations": schema.ListNestedAttribute{
+				Description: "The matching organizations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the organization.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the organization.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the organization.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The creation time of the organization, in RFC3339 format.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "The last update time of the organization, in RFC3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state OrganizationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namePrefix := state.NamePrefix.ValueString()
+
+	tflog.Debug(ctx, "Listing organizations", map[string]any{"name_prefix": namePrefix})
+
+	organizations, err := findAllOrganizations(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Organizations",
+			"Could not list organizations: "+formatAPIError(err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("organizations")
+	state.Organizations = []OrganizationsDataItemModel{}
+
+	for _, organization := range organizations {
+		if !strings.HasPrefix(organization.Name, namePrefix) {
+			continue
+		}
+
+		item := OrganizationsDataItemModel{
+			ID:          types.StringValue(""),
+			Name:        types.StringValue(organization.Name),
+			Description: types.StringValue(""),
+			CreatedAt:   timestampValue(organization.CreatedAt),
+			UpdatedAt:   timestampValue(organization.UpdatedAt),
+		}
+		if organization.Id != nil {
+			item.ID = types.StringValue(*organization.Id)
+		}
+		if organization.Description != nil {
+			item.Description = types.StringValue(*organization.Description)
+		}
+
+		state.Organizations = append(state.Organizations, item)
+	}
+
+	tflog.Trace(ctx, "Listed organizations", map[string]any{"count": len(state.Organizations)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper function to page through all organizations
+func findAllOrganizations(ctx context.Context, client influxdb2.Client) ([]domain.Organization, error) {
+	organizations := []domain.Organization{}
+	for offset := 0; ; offset += organizationsPageSize {
+		page, err := client.OrganizationsAPI().GetOrganizations(ctx, api.PagingWithOffset(offset), api.PagingWithLimit(organizationsPageSize))
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			break
+		}
+
+		organizations = append(organizations, *page...)
+		if len(*page) < organizationsPageSize {
+			break
+		}
+	}
+
+	return organizations, nil
+}
@@ -0,0 +1,59 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationsDataSource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb-v2_organizations.test", "id", "organizations"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb-v2_organizations.test", "organizations.*", map[string]string{
+						"id": orgID,
+					}),
+					resource.TestMatchResourceAttr("data.influxdb-v2_organizations.test", "organizations.0.created_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestMatchResourceAttr("data.influxdb-v2_organizations.test", "organizations.0.updated_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsDataSource_NamePrefix(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationsDataSourceConfigNamePrefix("prefix-that-does-not-exist-"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb-v2_organizations.test", "organizations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOrganizationsDataSourceConfig = `
+data "influxdb-v2_organizations" "test" {}
+`
+
+func testAccOrganizationsDataSourceConfigNamePrefix(namePrefix string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_organizations" "test" {
+  name_prefix = %[1]q
+}
+`, namePrefix)
+}
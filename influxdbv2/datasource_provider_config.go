@@ -0,0 +1,93 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProviderConfigDataSource{}
+
+func NewProviderConfigDataSource() datasource.DataSource {
+	return &ProviderConfigDataSource{}
+}
+
+// ProviderConfigDataSource defines the data source implementation.
+type ProviderConfigDataSource struct {
+	providerData *influxdbProviderData
+}
+
+// ProviderConfigDataSourceModel describes the data source data model.
+type ProviderConfigDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	URL         types.String `tfsdk:"url"`
+	TokenSource types.String `tfsdk:"token_source"`
+	OrgID       types.String `tfsdk:"org_id"`
+	OrgName     types.String `tfsdk:"org_name"`
+}
+
+func (d *ProviderConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_config"
+}
+
+func (d *ProviderConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source returning the provider configuration resolved from attributes and environment variables.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (server URL).",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The resolved InfluxDB server URL.",
+				Computed:    true,
+			},
+			"token_source": schema.StringAttribute{
+				Description: "How the provider authenticates: 'attribute', 'profile' or 'environment' for a token, 'token_file' or 'password'. The token itself is never exposed.",
+				Computed:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The resolved default organization ID, empty if none is configured.",
+				Computed:    true,
+			},
+			"org_name": schema.StringAttribute{
+				Description: "The name of the default organization, empty if none is configured or it could not be read.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ProviderConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *ProviderConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := ProviderConfigDataSourceModel{
+		ID:          types.StringValue(d.providerData.url),
+		URL:         types.StringValue(d.providerData.url),
+		TokenSource: types.StringValue(d.providerData.tokenSource),
+		OrgID:       types.StringValue(d.providerData.orgID),
+		OrgName:     types.StringValue(d.providerData.orgName),
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,51 @@
+package influxdbv2
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProviderConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfigDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_provider_config.test", "id"),
+					resource.TestMatchResourceAttr("data.influxdb-v2_provider_config.test", "url", regexp.MustCompile(`^http://`)),
+					resource.TestCheckResourceAttr("data.influxdb-v2_provider_config.test", "token_source", "environment"),
+					resource.TestCheckNoResourceAttr("data.influxdb-v2_provider_config.test", "token"),
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_provider_config.test", "org_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigDataSource_InvalidOrgID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "influxdb-v2" {
+  org_id = "000000000000000a"
+}
+
+data "influxdb-v2_provider_config" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Invalid InfluxDB Organization ID`),
+			},
+		},
+	})
+}
+
+const testAccProviderConfigDataSourceConfig = `
+data "influxdb-v2_provider_config" "test" {}
+`
@@ -26,11 +26,12 @@ type ReadyDataSource struct {
 
 // ReadyDataSourceModel describes the data source data model.
 type ReadyDataSourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	URL     types.String `tfsdk:"url"`
-	Ready   types.Bool   `tfsdk:"ready"`
-	Status  types.String `tfsdk:"status"`
-	Started types.String `tfsdk:"started"`
+	ID        types.String `tfsdk:"id"`
+	URL       types.String `tfsdk:"url"`
+	Ready     types.Bool   `tfsdk:"ready"`
+	Status    types.String `tfsdk:"status"`
+	Started   types.String `tfsdk:"started"`
+	LatencyMs types.Int64  `tfsdk:"latency_ms"`
 }
 
 func (d *ReadyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -61,6 +62,10 @@ func (d *ReadyDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Description: "Timestamp when the server started.",
 				Computed:    true,
 			},
+			"latency_ms": schema.Int64Attribute{
+				Description: "How long the readiness check took, in milliseconds.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -70,16 +75,16 @@ func (d *ReadyDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(influxdb2.Client)
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 func (d *ReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -88,11 +93,13 @@ func (d *ReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	tflog.Debug(ctx, "Checking if InfluxDB server is ready")
 
 	// Check if server is ready
+	start := time.Now()
 	ready, err := d.client.Ready(ctx)
+	latency := time.Since(start)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Checking Server Status",
-			"Could not check if server is ready: "+err.Error(),
+			"Could not check if server is ready: "+formatAPIError(err),
 		)
 		return
 	}
@@ -104,6 +111,7 @@ func (d *ReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	state.ID = types.StringValue(serverURL)
 	state.URL = types.StringValue(serverURL)
 	state.Ready = types.BoolValue(true) // If we got here, server is ready
+	state.LatencyMs = types.Int64Value(latency.Milliseconds())
 
 	if ready.Status != nil {
 		state.Status = types.StringValue(string(*ready.Status))
@@ -124,8 +132,9 @@ func (d *ReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	tflog.Trace(ctx, "InfluxDB server ready check completed", map[string]any{
-		"url":   serverURL,
-		"ready": true,
+		"url":        serverURL,
+		"ready":      true,
+		"latency_ms": latency.Milliseconds(),
 	})
 
 	// Save data into Terraform state
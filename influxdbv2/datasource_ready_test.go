@@ -21,6 +21,7 @@ func TestAccReadyDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.influxdb-v2_ready.test", "ready", "true"),
 					resource.TestCheckResourceAttrSet("data.influxdb-v2_ready.test", "status"),
 					resource.TestCheckResourceAttrSet("data.influxdb-v2_ready.test", "started"),
+					resource.TestMatchResourceAttr("data.influxdb-v2_ready.test", "latency_ms", regexp.MustCompile(`^\d+$`)),
 				),
 			},
 		},
@@ -0,0 +1,226 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+const (
+	// defaultTaskRunsLimit is used when limit is not set.
+	defaultTaskRunsLimit = 100
+	// taskRunsPageSize is the maximum number of runs returned by the API per request.
+	taskRunsPageSize = 500
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TaskRunsDataSource{}
+
+func NewTaskRunsDataSource() datasource.DataSource {
+	return &TaskRunsDataSource{}
+}
+
+// TaskRunsDataSource defines the data source implementation.
+type TaskRunsDataSource struct {
+	client influxdb2.Client
+}
+
+// TaskRunsDataSourceModel describes the data source data model.
+type TaskRunsDataSourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	TaskID    types.String            `tfsdk:"task_id"`
+	Limit     types.Int64             `tfsdk:"limit"`
+	AfterTime types.String            `tfsdk:"after_time"`
+	Runs      []TaskRunsDataItemModel `tfsdk:"runs"`
+}
+
+// TaskRunsDataItemModel describes a single run returned by the data source.
+type TaskRunsDataItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Status     types.String `tfsdk:"status"`
+	StartedAt  types.String `tfsdk:"started_at"`
+	FinishedAt types.String `tfsdk:"finished_at"`
+}
+
+func (d *TaskRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_runs"
+}
+
+func (d *TaskRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to list the historical runs of a task.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (task ID).",
+				Computed:    true,
+			},
+			"task_id": schema.StringAttribute{
+				Description: "The ID of the task to list runs for.",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of runs to return. Defaults to 100.",
+				Optional:    true,
+			},
+			"after_time": schema.StringAttribute{
+				Description: "Only return runs scheduled after this time, in RFC3339 format.",
+				Optional:    true,
+			},
+			"runs": schema.ListNestedAttribute{
+				Description: "The runs of the task, empty when it never ran.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the run.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the run, such as 'success' or 'failed'.",
+							Computed:    true,
+						},
+						"started_at": schema.StringAttribute{
+							Description: "The time the run started, in RFC3339 format. Empty if it did not start.",
+							Computed:    true,
+						},
+						"finished_at": schema.StringAttribute{
+							Description: "The time the run finished, in RFC3339 format. Empty if it did not finish.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TaskRunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *TaskRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TaskRunsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := defaultTaskRunsLimit
+	if !state.Limit.IsNull() {
+		if state.Limit.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limit"),
+				"Invalid Limit",
+				"The limit must be greater than zero.",
+			)
+			return
+		}
+		limit = int(state.Limit.ValueInt64())
+	}
+
+	var afterTime time.Time
+	if !state.AfterTime.IsNull() {
+		var err error
+		afterTime, err = time.Parse(time.RFC3339, state.AfterTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("after_time"),
+				"Invalid After Time",
+				"The after_time must be in RFC3339 format: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	taskID := state.TaskID.ValueString()
+
+	tflog.Debug(ctx, "Listing task runs", map[string]any{"task_id": taskID, "limit": limit})
+
+	runs, err := findTaskRuns(ctx, d.client, taskID, afterTime, limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Task Runs",
+			"Could not list runs of task ID "+taskID+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(taskID)
+	state.Runs = []TaskRunsDataItemModel{}
+	for _, run := range runs {
+		item := TaskRunsDataItemModel{
+			ID:         types.StringValue(""),
+			Status:     types.StringValue(""),
+			StartedAt:  timestampValue(run.StartedAt),
+			FinishedAt: timestampValue(run.FinishedAt),
+		}
+		if run.Id != nil {
+			item.ID = types.StringValue(*run.Id)
+		}
+		if run.Status != nil {
+			item.Status = types.StringValue(string(*run.Status))
+		}
+
+		state.Runs = append(state.Runs, item)
+	}
+
+	tflog.Trace(ctx, "Listed task runs", map[string]any{"task_id": taskID, "count": len(state.Runs)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper function to page through the runs of a task, up to limit runs. The
+// generated client is used directly since TasksAPI().FindRunsWithID does not
+// handle a response without runs.
+func findTaskRuns(ctx context.Context, client influxdb2.Client, taskID string, afterTime time.Time, limit int) ([]domain.Run, error) {
+	runs := []domain.Run{}
+	params := &domain.GetTasksIDRunsAllParams{TaskID: taskID}
+	if !afterTime.IsZero() {
+		params.AfterTime = &afterTime
+	}
+
+	for len(runs) < limit {
+		pageSize := min(limit-len(runs), taskRunsPageSize)
+		params.Limit = &pageSize
+
+		result, err := client.APIClient().GetTasksIDRuns(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if result.Runs == nil {
+			break
+		}
+
+		page := *result.Runs
+		runs = append(runs, page...)
+		if len(page) < pageSize || page[len(page)-1].Id == nil {
+			break
+		}
+		params.After = page[len(page)-1].Id
+	}
+
+	return runs, nil
+}
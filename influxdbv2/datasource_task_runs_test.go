@@ -0,0 +1,49 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTaskRunsDataSource_UnknownTask(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskRunsDataSourceConfig("000000000000000a", 10, "2024-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`Error Listing Task Runs`),
+			},
+		},
+	})
+}
+
+func TestAccTaskRunsDataSource_InvalidArguments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskRunsDataSourceConfig("000000000000000a", 0, "2024-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`Invalid Limit`),
+			},
+			{
+				Config:      testAccTaskRunsDataSourceConfig("000000000000000a", 10, "yesterday"),
+				ExpectError: regexp.MustCompile(`Invalid After Time`),
+			},
+		},
+	})
+}
+
+func testAccTaskRunsDataSourceConfig(taskID string, limit int, afterTime string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_task_runs" "test" {
+  task_id    = %[1]q
+  limit      = %[2]d
+  after_time = %[3]q
+}
+`, taskID, limit, afterTime)
+}
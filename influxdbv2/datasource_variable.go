@@ -0,0 +1,301 @@
+package influxdbv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VariableDataSource{}
+
+func NewVariableDataSource() datasource.DataSource {
+	return &VariableDataSource{}
+}
+
+// VariableDataSource defines the data source implementation.
+type VariableDataSource struct {
+	client influxdb2.Client
+}
+
+// VariableDataSourceModel describes the data source data model.
+type VariableDataSourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	OrgID       types.String            `tfsdk:"org_id"`
+	Name        types.String            `tfsdk:"name"`
+	Description types.String            `tfsdk:"description"`
+	Type        types.String            `tfsdk:"type"`
+	Selected    types.List              `tfsdk:"selected"`
+	Arguments   *VariableArgumentsModel `tfsdk:"arguments"`
+	Labels      types.List              `tfsdk:"labels"`
+}
+
+// VariableArgumentsModel describes the arguments of a variable, of which only
+// the attributes matching its type are set.
+type VariableArgumentsModel struct {
+	Values    types.List   `tfsdk:"values"`
+	MapValues types.Map    `tfsdk:"map_values"`
+	Query     types.String `tfsdk:"query"`
+	Language  types.String `tfsdk:"language"`
+}
+
+// variableArguments is the JSON form of the arguments of a variable, whose
+// values depend on the type.
+type variableArguments struct {
+	Type   string          `json:"type"`
+	Values json.RawMessage `json:"values"`
+}
+
+func (d *VariableDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable"
+}
+
+func (d *VariableDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source to look up a variable of an organization by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the variable.",
+				Computed:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID of the variable.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the variable.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the variable.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the variable, 'constant', 'map' or 'query'.",
+				Computed:    true,
+			},
+			"selected": schema.ListAttribute{
+				Description: "The selected values of the variable.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"arguments": schema.SingleNestedAttribute{
+				Description: "The arguments of the variable. Only the attributes matching its type are set.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"values": schema.ListAttribute{
+						Description: "The values of a constant variable.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"map_values": schema.MapAttribute{
+						Description: "The values of a map variable, by key.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"query": schema.StringAttribute{
+						Description: "The query of a query variable.",
+						Computed:    true,
+					},
+					"language": schema.StringAttribute{
+						Description: "The language of the query of a query variable.",
+						Computed:    true,
+					},
+				},
+			},
+			"labels": schema.ListAttribute{
+				Description: "The IDs of the labels attached to the variable.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *VariableDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *VariableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state VariableDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := state.OrgID.ValueString()
+	name := state.Name.ValueString()
+
+	tflog.Debug(ctx, "Finding variable", map[string]any{"org_id": orgID, "name": name})
+
+	result, err := d.client.APIClient().GetVariables(ctx, &domain.GetVariablesParams{OrgID: &orgID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Variables",
+			"Could not list variables for organization ID "+orgID+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	var matches []domain.Variable
+	if result.Variables != nil {
+		for _, variable := range *result.Variables {
+			if variable.Name == name {
+				matches = append(matches, variable)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Variable Not Found",
+			"No variable named "+name+" was found in organization ID "+orgID+".",
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous Variable Name",
+			fmt.Sprintf("Found %d variables named %s in organization ID %s.", len(matches), name, orgID),
+		)
+		return
+	}
+
+	variable := matches[0]
+	state.ID = types.StringValue(*variable.Id)
+	state.Description = types.StringValue("")
+	if variable.Description != nil {
+		state.Description = types.StringValue(*variable.Description)
+	}
+
+	selected := []string{}
+	if variable.Selected != nil {
+		selected = *variable.Selected
+	}
+	var diags diag.Diagnostics
+	state.Selected, diags = types.ListValueFrom(ctx, types.StringType, selected)
+	resp.Diagnostics.Append(diags...)
+
+	variableType, arguments, err := variableArgumentsFromDomain(ctx, variable.Arguments)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Variable Arguments",
+			"Could not read the arguments of variable ID "+*variable.Id+": "+err.Error(),
+		)
+		return
+	}
+	state.Type = types.StringValue(variableType)
+	state.Arguments = arguments
+
+	labelIDs, err := d.readVariableLabels(ctx, *variable.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Variable Labels",
+			"Could not read the labels of variable ID "+*variable.Id+": "+formatAPIError(err),
+		)
+		return
+	}
+	state.Labels, diags = types.ListValueFrom(ctx, types.StringType, labelIDs)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "Found variable", map[string]any{"id": state.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper function to list the IDs of the labels attached to a variable
+func (d *VariableDataSource) readVariableLabels(ctx context.Context, variableID string) ([]string, error) {
+	result, err := d.client.APIClient().GetVariablesIDLabels(ctx, &domain.GetVariablesIDLabelsAllParams{VariableID: variableID})
+	if err != nil {
+		return nil, err
+	}
+
+	labelIDs := []string{}
+	if result.Labels != nil {
+		for _, label := range *result.Labels {
+			if label.Id != nil {
+				labelIDs = append(labelIDs, *label.Id)
+			}
+		}
+	}
+
+	return labelIDs, nil
+}
+
+// Helper function to rebuild the typed arguments of a variable, which the
+// client only decodes as generic JSON
+func variableArgumentsFromDomain(ctx context.Context, properties domain.VariableProperties) (string, *VariableArgumentsModel, error) {
+	content, err := json.Marshal(properties)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var arguments variableArguments
+	if err := json.Unmarshal(content, &arguments); err != nil {
+		return "", nil, err
+	}
+
+	model := &VariableArgumentsModel{
+		Values:    types.ListNull(types.StringType),
+		MapValues: types.MapNull(types.StringType),
+		Query:     types.StringNull(),
+		Language:  types.StringNull(),
+	}
+
+	var diags diag.Diagnostics
+	switch arguments.Type {
+	case "constant":
+		var values []string
+		if err := json.Unmarshal(arguments.Values, &values); err != nil {
+			return "", nil, fmt.Errorf("invalid constant values: %w", err)
+		}
+		model.Values, diags = types.ListValueFrom(ctx, types.StringType, values)
+	case "map":
+		var values map[string]string
+		if err := json.Unmarshal(arguments.Values, &values); err != nil {
+			return "", nil, fmt.Errorf("invalid map values: %w", err)
+		}
+		model.MapValues, diags = types.MapValueFrom(ctx, types.StringType, values)
+	case "query":
+		var values struct {
+			Query    string `json:"query"`
+			Language string `json:"language"`
+		}
+		if err := json.Unmarshal(arguments.Values, &values); err != nil {
+			return "", nil, fmt.Errorf("invalid query values: %w", err)
+		}
+		model.Query = types.StringValue(values.Query)
+		model.Language = types.StringValue(values.Language)
+	default:
+		return "", nil, fmt.Errorf("unsupported variable type %q", arguments.Type)
+	}
+	if diags.HasError() {
+		return "", nil, fmt.Errorf("error converting %s values", arguments.Type)
+	}
+
+	return arguments.Type, model, nil
+}
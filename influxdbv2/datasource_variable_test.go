@@ -0,0 +1,143 @@
+package influxdbv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func TestVariableArgumentsFromDomain(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    string
+		expectedType string
+		check        func(model *VariableArgumentsModel) bool
+		expectedErr  bool
+	}{
+		{
+			name:         "constant",
+			arguments:    `{"type": "constant", "values": ["a", "b"]}`,
+			expectedType: "constant",
+			check: func(model *VariableArgumentsModel) bool {
+				return len(model.Values.Elements()) == 2 && model.MapValues.IsNull() && model.Query.IsNull()
+			},
+		},
+		{
+			name:         "map",
+			arguments:    `{"type": "map", "values": {"first": "1", "second": "2"}}`,
+			expectedType: "map",
+			check: func(model *VariableArgumentsModel) bool {
+				return model.MapValues.Elements()["second"] == types.StringValue("2") && model.Values.IsNull()
+			},
+		},
+		{
+			name:         "query",
+			arguments:    `{"type": "query", "values": {"query": "buckets()", "language": "flux"}}`,
+			expectedType: "query",
+			check: func(model *VariableArgumentsModel) bool {
+				return model.Query.ValueString() == "buckets()" && model.Language.ValueString() == "flux"
+			},
+		},
+		{
+			name:        "unknown type",
+			arguments:   `{"type": "system", "values": []}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var properties domain.VariableProperties
+			if err := json.Unmarshal([]byte(test.arguments), &properties); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			variableType, model, err := variableArgumentsFromDomain(context.Background(), properties)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("expected error %t, got %v", test.expectedErr, err)
+			}
+			if test.expectedErr {
+				return
+			}
+			if variableType != test.expectedType {
+				t.Errorf("expected type %s, got %s", test.expectedType, variableType)
+			}
+			if !test.check(model) {
+				t.Errorf("unexpected arguments: %+v", model)
+			}
+		})
+	}
+}
+
+func TestReadVariableLabels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/variables/0000000000000001/labels" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"labels":[{"id":"0000000000000002","name":"production"},{"id":"0000000000000003","name":"team"}]}`)
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	d := &VariableDataSource{client: client}
+	labelIDs, err := d.readVariableLabels(context.Background(), "0000000000000001")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(labelIDs) != 2 || labelIDs[0] != "0000000000000002" || labelIDs[1] != "0000000000000003" {
+		t.Errorf("expected the IDs of both labels, got %v", labelIDs)
+	}
+}
+
+func TestAccVariableDataSource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateVariable(t, orgID, "tf_acc_variable_datasource")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableDataSourceConfig(orgID, "tf_acc_variable_datasource"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_variable.test", "id"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_variable.test", "type", "constant"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_variable.test", "arguments.values.#", "2"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_variable.test", "arguments.values.0", "a"),
+					resource.TestCheckNoResourceAttr("data.influxdb-v2_variable.test", "arguments.query"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_variable.test", "labels.#", "0"),
+				),
+			},
+			{
+				Config:      testAccVariableDataSourceConfig(orgID, "tf_acc_missing_variable"),
+				ExpectError: regexp.MustCompile(`Variable Not Found`),
+			},
+		},
+	})
+}
+
+func testAccVariableDataSourceConfig(orgID, name string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_variable" "test" {
+  org_id = %[1]q
+  name   = %[2]q
+}
+`, orgID, name)
+}
@@ -0,0 +1,163 @@
+package influxdbv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+const (
+	// defaultWaitHealthyTimeout is used when timeout_seconds is not set.
+	defaultWaitHealthyTimeout = 60 * time.Second
+	// waitHealthyPollInterval is the delay between two health checks.
+	waitHealthyPollInterval = 2 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WaitHealthyDataSource{}
+
+func NewWaitHealthyDataSource() datasource.DataSource {
+	return &WaitHealthyDataSource{}
+}
+
+// WaitHealthyDataSource defines the data source implementation.
+type WaitHealthyDataSource struct {
+	client influxdb2.Client
+}
+
+// WaitHealthyDataSourceModel describes the data source data model.
+type WaitHealthyDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+	Status         types.String `tfsdk:"status"`
+	Version        types.String `tfsdk:"version"`
+}
+
+func (d *WaitHealthyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wait_healthy"
+}
+
+func (d *WaitHealthyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Data source waiting until the InfluxDB server reports a healthy status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier (server URL).",
+				Computed:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the server to become healthy, in seconds. Defaults to 60.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The health status of the server.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version of the server.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *WaitHealthyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *WaitHealthyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state WaitHealthyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultWaitHealthyTimeout
+	if !state.TimeoutSeconds.IsNull() {
+		if state.TimeoutSeconds.ValueInt64() <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout_seconds"),
+				"Invalid Timeout",
+				"The timeout_seconds must be greater than zero.",
+			)
+			return
+		}
+		timeout = time.Duration(state.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	tflog.Debug(ctx, "Waiting for InfluxDB server to be healthy", map[string]any{"timeout": timeout.String()})
+
+	health, err := d.waitHealthy(ctx, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Timeout Waiting For Healthy Server",
+			fmt.Sprintf("The InfluxDB server did not report a healthy status within %s: %s", timeout, err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(d.client.ServerURL())
+	state.Status = types.StringValue(string(health.Status))
+	state.Version = types.StringValue("")
+	if health.Version != nil {
+		state.Version = types.StringValue(*health.Version)
+	}
+
+	tflog.Trace(ctx, "InfluxDB server is healthy", map[string]any{"version": state.Version.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Helper function to poll the health endpoint until the server passes its
+// checks, returning the last failure when the timeout expires
+func (d *WaitHealthyDataSource) waitHealthy(ctx context.Context, timeout time.Duration) (*domain.HealthCheck, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		health, err := d.client.Health(ctx)
+		switch {
+		case err != nil:
+			err = errors.New(formatAPIError(err))
+		case health.Status != domain.HealthCheckStatusPass:
+			err = fmt.Errorf("status is %s", health.Status)
+			if health.Message != nil {
+				err = fmt.Errorf("status is %s: %s", health.Status, *health.Message)
+			}
+		default:
+			return health, nil
+		}
+
+		tflog.Trace(ctx, "InfluxDB server is not healthy yet", map[string]any{"error": err.Error()})
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(waitHealthyPollInterval):
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestAccWaitHealthyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccWaitHealthyDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_wait_healthy.test", "id"),
+					resource.TestCheckResourceAttr("data.influxdb-v2_wait_healthy.test", "status", "pass"),
+					resource.TestCheckResourceAttrSet("data.influxdb-v2_wait_healthy.test", "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWaitHealthyDataSource_InvalidTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "influxdb-v2_wait_healthy" "test" {
+  timeout_seconds = 0
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Timeout`),
+			},
+		},
+	})
+}
+
+const testAccWaitHealthyDataSourceConfig = `
+data "influxdb-v2_wait_healthy" "test" {
+  timeout_seconds = 30
+}
+`
+
+func TestWaitHealthy(t *testing.T) {
+	var checks atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The server is still starting when the first check is made.
+		if checks.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"name":"influxdb","status":"fail","message":"starting","checks":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"name":"influxdb","status":"pass","version":"v2.7.1","checks":[]}`)
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	d := &WaitHealthyDataSource{client: client}
+	health, err := d.waitHealthy(context.Background(), 10*time.Second)
+	if err != nil {
+		t.Fatalf("expected the server to become healthy, got: %s", err)
+	}
+	if health.Version == nil || *health.Version != "v2.7.1" {
+		t.Errorf("expected version v2.7.1, got %v", health.Version)
+	}
+	if checks.Load() != 2 {
+		t.Errorf("expected 2 health checks, got %d", checks.Load())
+	}
+}
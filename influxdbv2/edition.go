@@ -0,0 +1,70 @@
+package influxdbv2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// InfluxDB editions, as reported by the X-Influxdb-Build header of the ping
+// endpoint. The edition is empty when it could not be detected.
+const (
+	editionOSS   = "oss"
+	editionCloud = "cloud"
+)
+
+// buildHeader is the response header naming the InfluxDB build.
+const buildHeader = "X-Influxdb-Build"
+
+// Helper function to map the build header to an edition
+func editionFromBuild(build string) string {
+	switch strings.ToLower(strings.TrimSpace(build)) {
+	case "oss":
+		return editionOSS
+	case "cloud":
+		return editionCloud
+	default:
+		return ""
+	}
+}
+
+// Helper function to detect the edition of the server from its ping endpoint,
+// which does not require authentication
+func detectEdition(ctx context.Context, client influxdb2.Client) (string, error) {
+	service := client.HTTPService()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, service.ServerURL()+"ping", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var edition string
+	if herr := service.DoHTTPRequest(req, nil, func(resp *http.Response) error {
+		edition = editionFromBuild(resp.Header.Get(buildHeader))
+		return resp.Body.Close()
+	}); herr != nil {
+		return "", herr
+	}
+
+	return edition, nil
+}
+
+// Helper function to reject a feature only available on the given edition.
+// Nothing is reported when the edition of the server is unknown, the API
+// error then explains the failure.
+func requireEdition(edition, required, feature string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if edition == "" || edition == required {
+		return diags
+	}
+
+	names := map[string]string{editionOSS: "InfluxDB OSS", editionCloud: "InfluxDB Cloud"}
+	diags.AddError(
+		"Unsupported InfluxDB Edition",
+		feature+" is only available on "+names[required]+", but the server is "+names[edition]+".",
+	)
+
+	return diags
+}
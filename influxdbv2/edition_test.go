@@ -0,0 +1,77 @@
+package influxdbv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestEditionFromBuild(t *testing.T) {
+	tests := []struct {
+		build    string
+		expected string
+	}{
+		{build: "OSS", expected: editionOSS},
+		{build: "Cloud", expected: editionCloud},
+		{build: "cloud2", expected: ""},
+		{build: "", expected: ""},
+	}
+
+	for _, test := range tests {
+		if actual := editionFromBuild(test.build); actual != test.expected {
+			t.Errorf("editionFromBuild(%q) = %q; expected %q", test.build, actual, test.expected)
+		}
+	}
+}
+
+func TestDetectEdition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ping" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set(buildHeader, "OSS")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "")
+	defer client.Close()
+
+	edition, err := detectEdition(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if edition != editionOSS {
+		t.Fatalf("expected edition %q, got %q", editionOSS, edition)
+	}
+
+	// An OSS server attempting a Cloud-only resource
+	diags := requireEdition(edition, editionCloud, "Test resource")
+	if !diags.HasError() {
+		t.Error("expected an error for a Cloud-only resource on OSS")
+	}
+}
+
+func TestRequireEdition(t *testing.T) {
+	tests := []struct {
+		edition  string
+		required string
+		error    bool
+	}{
+		{edition: editionCloud, required: editionCloud, error: false},
+		{edition: editionOSS, required: editionCloud, error: true},
+		{edition: editionCloud, required: editionOSS, error: true},
+		{edition: "", required: editionCloud, error: false},
+	}
+
+	for _, test := range tests {
+		diags := requireEdition(test.edition, test.required, "Test resource")
+		if diags.HasError() != test.error {
+			t.Errorf("requireEdition(%q, %q) error = %t; expected %t", test.edition, test.required, diags.HasError(), test.error)
+		}
+	}
+}
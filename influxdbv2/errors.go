@@ -0,0 +1,66 @@
+package influxdbv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Helper function to format an error for diagnostics. Errors returned by the
+// InfluxDB API carry a code and a message, which are spelled out together with
+// the HTTP status, keeping any context the error was wrapped with.
+func formatAPIError(err error) string {
+	var apiErr *http.Error
+	if !errors.As(err, &apiErr) || apiErr.Err != nil || apiErr.Code == "" || apiErr.Message == "" {
+		return err.Error()
+	}
+
+	prefix := strings.TrimSuffix(err.Error(), apiErr.Error())
+
+	return fmt.Sprintf("%s%s (code: %s, HTTP status %d)", prefix, apiErr.Message, apiErr.Code, apiErr.StatusCode)
+}
+
+// Helper function reporting whether an error is the InfluxDB API answer for a
+// missing object, possibly wrapped. The generated API client does not return
+// typed errors, only the error code of JSON answers or the HTTP status of the
+// others, which are recognized from their message.
+func isNotFoundError(err error) bool {
+	var apiErr *http.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 404
+	}
+
+	for ; err != nil; err = errors.Unwrap(err) {
+		message := err.Error()
+		if strings.HasPrefix(message, string(domain.ErrorCodeNotFound)+": ") || strings.HasPrefix(message, "404 ") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to call fn until it stops failing with a not-found error,
+// at most attempts times. The delay between attempts starts at backoff and
+// doubles each time. Other errors are returned right away.
+func retryNotFound(ctx context.Context, attempts int, backoff time.Duration, fn func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil || !isNotFoundError(err) || attempt >= attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
@@ -0,0 +1,112 @@
+package influxdbv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/api/http"
+)
+
+func TestFormatAPIError(t *testing.T) {
+	conflict := &http.Error{StatusCode: 422, Code: "conflict", Message: "bucket with name test already exists"}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "plain error",
+			err:      errors.New("connection refused"),
+			expected: "connection refused",
+		},
+		{
+			name:     "api error",
+			err:      conflict,
+			expected: "bucket with name test already exists (code: conflict, HTTP status 422)",
+		},
+		{
+			name:     "wrapped api error",
+			err:      fmt.Errorf("error finding bucket: %w", &http.Error{StatusCode: 404, Code: "not found", Message: "bucket not found"}),
+			expected: "error finding bucket: bucket not found (code: not found, HTTP status 404)",
+		},
+		{
+			name:     "api error without payload",
+			err:      &http.Error{StatusCode: 502},
+			expected: "Unexpected status code 502",
+		},
+		{
+			name:     "transport error",
+			err:      http.NewError(errors.New("i/o timeout")),
+			expected: "i/o timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := formatAPIError(test.err); actual != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "plain error", err: errors.New("not found"), expected: false},
+		{name: "not found", err: &http.Error{StatusCode: 404, Code: "not found"}, expected: true},
+		{name: "wrapped not found", err: fmt.Errorf("error finding label: %w", &http.Error{StatusCode: 404}), expected: true},
+		{name: "other status", err: &http.Error{StatusCode: 500}, expected: false},
+		{name: "API client not found", err: errors.New("not found: bucket not found"), expected: true},
+		{name: "wrapped API client not found", err: fmt.Errorf("error finding bucket: %w", errors.New("not found: bucket not found")), expected: true},
+		{name: "API client status", err: errors.New("404 Not Found"), expected: true},
+		{name: "API client other code", err: errors.New("internal error: not found in cache"), expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := isNotFoundError(test.err); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRetryNotFound(t *testing.T) {
+	notFound := fmt.Errorf("error finding bucket: %w", &http.Error{StatusCode: 404})
+
+	tests := []struct {
+		name          string
+		errs          []error
+		expectedCalls int
+		expectedErr   bool
+	}{
+		{name: "visible right away", errs: []error{nil}, expectedCalls: 1},
+		{name: "delayed visibility", errs: []error{notFound, notFound, nil}, expectedCalls: 3},
+		{name: "never visible", errs: []error{notFound, notFound, notFound, nil}, expectedCalls: 3, expectedErr: true},
+		{name: "other error", errs: []error{&http.Error{StatusCode: 500}, nil}, expectedCalls: 1, expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			err := retryNotFound(context.Background(), 3, time.Millisecond, func() error {
+				calls++
+				return test.errs[calls-1]
+			})
+			if (err != nil) != test.expectedErr {
+				t.Errorf("expected error %t, got %v", test.expectedErr, err)
+			}
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// PermissionModel describes the permission data model.
+type PermissionModel struct {
+	Action   types.String `tfsdk:"action"`
+	Resource types.Set    `tfsdk:"resource"`
+}
+
+// ResourceModel describes the resource data model.
+type ResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Org   types.String `tfsdk:"org"`
+	OrgID types.String `tfsdk:"org_id"`
+	Type  types.String `tfsdk:"type"`
+}
+
+// permissionResourceObjectType is the type of a permission resource block.
+var permissionResourceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":     types.StringType,
+		"name":   types.StringType,
+		"org":    types.StringType,
+		"org_id": types.StringType,
+		"type":   types.StringType,
+	},
+}
+
+// permissionObjectType is the type of a permission block.
+var permissionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"action":   types.StringType,
+		"resource": types.SetType{ElemType: permissionResourceObjectType},
+	},
+}
+
+// Token presets of the authorization resource, matching the tokens offered by
+// the InfluxDB UI and CLI.
+const (
+	authorizationPresetAllAccess = "all_access"
+	authorizationPresetOperator  = "operator"
+)
+
+// presetResourceTypes lists the resource types granted by the presets. The
+// instance type is only granted to operator tokens.
+var presetResourceTypes = []domain.ResourceType{
+	domain.ResourceTypeAnnotations,
+	domain.ResourceTypeAuthorizations,
+	domain.ResourceTypeBuckets,
+	domain.ResourceTypeChecks,
+	domain.ResourceTypeDashboards,
+	domain.ResourceTypeDbrp,
+	domain.ResourceTypeDocuments,
+	domain.ResourceTypeLabels,
+	domain.ResourceTypeNotebooks,
+	domain.ResourceTypeNotificationEndpoints,
+	domain.ResourceTypeNotificationRules,
+	domain.ResourceTypeOrgs,
+	domain.ResourceTypeRemotes,
+	domain.ResourceTypeReplications,
+	domain.ResourceTypeScrapers,
+	domain.ResourceTypeSecrets,
+	domain.ResourceTypeSources,
+	domain.ResourceTypeTasks,
+	domain.ResourceTypeTelegrafs,
+	domain.ResourceTypeUsers,
+	domain.ResourceTypeVariables,
+	domain.ResourceTypeViews,
+}
+
+// Helper function to generate the permissions of a token preset. All-access
+// tokens read and write every resource of the organization, and the
+// organization itself. Operator tokens read and write every resource of the
+// instance.
+func presetPermissions(preset, orgID string) ([]domain.Permission, error) {
+	actions := []domain.PermissionAction{domain.PermissionActionRead, domain.PermissionActionWrite}
+
+	var permissions []domain.Permission
+	switch preset {
+	case authorizationPresetAllAccess:
+		for _, resourceType := range presetResourceTypes {
+			for _, action := range actions {
+				resource := domain.Resource{Type: resourceType}
+				if resourceType == domain.ResourceTypeOrgs {
+					id := orgID
+					resource.Id = &id
+				} else {
+					id := orgID
+					resource.OrgID = &id
+				}
+				permissions = append(permissions, domain.Permission{Action: action, Resource: resource})
+			}
+		}
+	case authorizationPresetOperator:
+		resourceTypes := append([]domain.ResourceType{domain.ResourceTypeInstance}, presetResourceTypes...)
+		for _, resourceType := range resourceTypes {
+			for _, action := range actions {
+				permissions = append(permissions, domain.Permission{Action: action, Resource: domain.Resource{Type: resourceType}})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported preset %q", preset)
+	}
+
+	return permissions, nil
+}
+
+// Helper function to convert permissions from Terraform Set to domain model
+func convertPermissionsToDomain(ctx context.Context, permsSet types.Set) ([]domain.Permission, error) {
+	var permissions []PermissionModel
+	diags := permsSet.ElementsAs(ctx, &permissions, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("error converting permissions set")
+	}
+
+	domainPermissions := []domain.Permission{}
+	for _, perm := range permissions {
+		var resources []ResourceModel
+		diags := perm.Resource.ElementsAs(ctx, &resources, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error converting resources set")
+		}
+
+		for _, res := range resources {
+			id := res.ID.ValueString()
+			orgID := res.OrgID.ValueString()
+			org := res.Org.ValueString()
+			name := res.Name.ValueString()
+
+			domainResource := domain.Resource{
+				Type:  domain.ResourceType(res.Type.ValueString()),
+				Id:    &id,
+				OrgID: &orgID,
+				Name:  &name,
+				Org:   &org,
+			}
+
+			domainPerm := domain.Permission{
+				Action:   domain.PermissionAction(perm.Action.ValueString()),
+				Resource: domainResource,
+			}
+
+			domainPermissions = append(domainPermissions, domainPerm)
+		}
+	}
+
+	return domainPermissions, nil
+}
+
+// Helper function to convert permissions from domain model to Terraform Set
+func convertPermissionsToTerraform(ctx context.Context, domainPerms []domain.Permission) (types.Set, error) {
+	// InfluxDB stores one resource per permission, the resources are grouped
+	// back by action so that a block listing several resources round-trips.
+	var actions []domain.PermissionAction
+	resourcesByAction := map[domain.PermissionAction][]attr.Value{}
+	for _, perm := range domainPerms {
+		id := ""
+		if perm.Resource.Id != nil {
+			id = *perm.Resource.Id
+		}
+		orgID := ""
+		if perm.Resource.OrgID != nil {
+			orgID = *perm.Resource.OrgID
+		}
+		name := ""
+		if perm.Resource.Name != nil {
+			name = *perm.Resource.Name
+		}
+		org := ""
+		if perm.Resource.Org != nil {
+			org = *perm.Resource.Org
+		}
+
+		resObj, diags := types.ObjectValue(
+			permissionResourceObjectType.AttrTypes,
+			map[string]attr.Value{
+				"id":     types.StringValue(id),
+				"name":   types.StringValue(name),
+				"org":    types.StringValue(org),
+				"org_id": types.StringValue(orgID),
+				"type":   types.StringValue(string(perm.Resource.Type)),
+			},
+		)
+		if diags.HasError() {
+			return types.SetNull(permissionObjectType), fmt.Errorf("error creating resource object")
+		}
+
+		if _, ok := resourcesByAction[perm.Action]; !ok {
+			actions = append(actions, perm.Action)
+		}
+		resourcesByAction[perm.Action] = append(resourcesByAction[perm.Action], resObj)
+	}
+
+	elements := []attr.Value{}
+	for _, action := range actions {
+		resourceSet, diags := types.SetValue(permissionResourceObjectType, resourcesByAction[action])
+		if diags.HasError() {
+			return types.SetNull(permissionObjectType), fmt.Errorf("error creating resource set")
+		}
+
+		permObj, diags := types.ObjectValue(
+			permissionObjectType.AttrTypes,
+			map[string]attr.Value{
+				"action":   types.StringValue(string(action)),
+				"resource": resourceSet,
+			},
+		)
+		if diags.HasError() {
+			return types.SetNull(permissionObjectType), fmt.Errorf("error creating permission object")
+		}
+		elements = append(elements, permObj)
+	}
+
+	setValue, diags := types.SetValue(permissionObjectType, elements)
+	if diags.HasError() {
+		return types.SetNull(permissionObjectType), fmt.Errorf("error creating permissions set")
+	}
+
+	return setValue, nil
+}
+
+// permissionEntry is a permission flattened to a single resource, so that
+// permission sets compare regardless of how resources are grouped in blocks.
+type permissionEntry struct {
+	action       string
+	resourceType string
+	id           string
+	name         string
+	org          string
+	orgID        string
+}
+
+// Helper function to flatten permissions returned by InfluxDB
+func permissionEntriesFromDomain(domainPerms []domain.Permission) []permissionEntry {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	entries := make([]permissionEntry, 0, len(domainPerms))
+	for _, perm := range domainPerms {
+		entries = append(entries, permissionEntry{
+			action:       string(perm.Action),
+			resourceType: string(perm.Resource.Type),
+			id:           value(perm.Resource.Id),
+			name:         value(perm.Resource.Name),
+			org:          value(perm.Resource.Org),
+			orgID:        value(perm.Resource.OrgID),
+		})
+	}
+
+	return entries
+}
+
+// Helper function to flatten permission blocks. It reports false when an
+// action, type or ID is unknown, as the permissions cannot be compared yet.
+// Unknown organization IDs are left empty and compared by organization name.
+func permissionEntriesFromSet(ctx context.Context, permsSet types.Set) ([]permissionEntry, bool, error) {
+	if permsSet.IsUnknown() {
+		return nil, false, nil
+	}
+
+	var permissions []PermissionModel
+	if diags := permsSet.ElementsAs(ctx, &permissions, false); diags.HasError() {
+		return nil, false, fmt.Errorf("error converting permissions set")
+	}
+
+	entries := []permissionEntry{}
+	for _, perm := range permissions {
+		if perm.Action.IsUnknown() || perm.Resource.IsUnknown() {
+			return nil, false, nil
+		}
+
+		var resources []ResourceModel
+		if diags := perm.Resource.ElementsAs(ctx, &resources, false); diags.HasError() {
+			return nil, false, fmt.Errorf("error converting resources set")
+		}
+
+		for _, res := range resources {
+			if res.ID.IsUnknown() || res.Type.IsUnknown() {
+				return nil, false, nil
+			}
+			entries = append(entries, permissionEntry{
+				action:       perm.Action.ValueString(),
+				resourceType: res.Type.ValueString(),
+				id:           res.ID.ValueString(),
+				name:         res.Name.ValueString(),
+				org:          res.Org.ValueString(),
+				orgID:        res.OrgID.ValueString(),
+			})
+		}
+	}
+
+	return entries, true, nil
+}
+
+// Helper function to check if two flattened permissions grant the same
+// access. Names are informational: an empty name, which InfluxDB may fill in,
+// matches any name. Organizations compare by ID when both sides know it, and
+// by name otherwise.
+func (e permissionEntry) matches(other permissionEntry) bool {
+	if e.action != other.action || e.resourceType != other.resourceType || e.id != other.id {
+		return false
+	}
+	if e.name != "" && other.name != "" && e.name != other.name {
+		return false
+	}
+
+	switch {
+	case e.orgID != "" && other.orgID != "":
+		return e.orgID == other.orgID
+	case e.org != "" && other.org != "":
+		return e.org == other.org
+	default:
+		return e.orgID == other.orgID && e.org == other.org
+	}
+}
+
+// Helper function to compare two flattened permission sets, ignoring their
+// order
+func samePermissions(a, b []permissionEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	matched := make([]bool, len(b))
+	for _, entry := range a {
+		found := false
+		for i, other := range b {
+			if !matched[i] && entry.matches(other) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
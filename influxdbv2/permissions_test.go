@@ -0,0 +1,316 @@
+package influxdbv2
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func TestConvertPermissionsToTerraform(t *testing.T) {
+	bucketID, orgID, orgName := "0b5e7f9c3a2d4680", "94d518926178fea7", "company"
+
+	tests := []struct {
+		name     string
+		perms    []domain.Permission
+		expected map[string][]ResourceModel
+	}{
+		{
+			name:     "no permissions",
+			perms:    nil,
+			expected: map[string][]ResourceModel{},
+		},
+		{
+			name: "grouped by action",
+			perms: []domain.Permission{
+				{Action: domain.PermissionActionRead, Resource: domain.Resource{Type: "buckets", Id: &bucketID, OrgID: &orgID}},
+				{Action: domain.PermissionActionWrite, Resource: domain.Resource{Type: "buckets", Id: &bucketID, OrgID: &orgID}},
+				{Action: domain.PermissionActionRead, Resource: domain.Resource{Type: "orgs", Id: &orgID, OrgID: &orgID}},
+			},
+			expected: map[string][]ResourceModel{
+				"read": {
+					testResourceModel(bucketID, "", "", orgID, "buckets"),
+					testResourceModel(orgID, "", "", orgID, "orgs"),
+				},
+				"write": {
+					testResourceModel(bucketID, "", "", orgID, "buckets"),
+				},
+			},
+		},
+		{
+			name: "named resource",
+			perms: []domain.Permission{
+				{Action: domain.PermissionActionRead, Resource: domain.Resource{Type: "buckets", Id: &bucketID, Name: &bucketID, Org: &orgName, OrgID: &orgID}},
+			},
+			expected: map[string][]ResourceModel{
+				"read": {testResourceModel(bucketID, bucketID, orgName, orgID, "buckets")},
+			},
+		},
+		{
+			name: "type wide resource",
+			perms: []domain.Permission{
+				{Action: domain.PermissionActionWrite, Resource: domain.Resource{Type: "buckets"}},
+			},
+			expected: map[string][]ResourceModel{
+				"write": {testResourceModel("", "", "", "", "buckets")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set, err := convertPermissionsToTerraform(context.Background(), test.perms)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var models []PermissionModel
+			if diags := set.ElementsAs(context.Background(), &models, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			actual := map[string][]ResourceModel{}
+			for _, model := range models {
+				var resources []ResourceModel
+				if diags := model.Resource.ElementsAs(context.Background(), &resources, false); diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				sort.Slice(resources, func(i, j int) bool {
+					return resources[i].Type.ValueString() < resources[j].Type.ValueString()
+				})
+				actual[model.Action.ValueString()] = resources
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestConvertPermissionsToDomain(t *testing.T) {
+	bucketID, orgID := "0b5e7f9c3a2d4680", "94d518926178fea7"
+
+	tests := []struct {
+		name      string
+		resources map[string][]ResourceModel
+		expected  int
+	}{
+		{
+			name:      "single resource",
+			resources: map[string][]ResourceModel{"read": {testResourceModel(bucketID, "", "", orgID, "buckets")}},
+			expected:  1,
+		},
+		{
+			name: "one permission per resource",
+			resources: map[string][]ResourceModel{
+				"read":  {testResourceModel(bucketID, "", "", orgID, "buckets"), testResourceModel(orgID, "", "", orgID, "orgs")},
+				"write": {testResourceModel(bucketID, "", "", orgID, "buckets")},
+			},
+			expected: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var models []PermissionModel
+			for action, resources := range test.resources {
+				resourceSet, diags := types.SetValueFrom(context.Background(), permissionResourceObjectType, resources)
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				models = append(models, PermissionModel{Action: types.StringValue(action), Resource: resourceSet})
+			}
+			set, diags := types.SetValueFrom(context.Background(), permissionObjectType, models)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			perms, err := convertPermissionsToDomain(context.Background(), set)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(perms) != test.expected {
+				t.Fatalf("expected %d permissions, got %d", test.expected, len(perms))
+			}
+
+			// Converting back groups the permissions as configured
+			roundTrip, err := convertPermissionsToTerraform(context.Background(), perms)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !roundTrip.Equal(set) {
+				t.Errorf("expected %v after a round trip, got %v", set, roundTrip)
+			}
+		})
+	}
+}
+
+// Helper function to build a permission resource model
+func TestPresetPermissions(t *testing.T) {
+	orgID := "94d518926178fea7"
+
+	tests := map[string]struct {
+		preset    string
+		count     int
+		scoped    bool
+		expectErr bool
+	}{
+		"all access": {
+			preset: authorizationPresetAllAccess,
+			count:  2 * len(presetResourceTypes),
+			scoped: true,
+		},
+		"operator": {
+			preset: authorizationPresetOperator,
+			count:  2 * (len(presetResourceTypes) + 1),
+		},
+		"unsupported": {
+			preset:    "admin",
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			permissions, err := presetPermissions(test.preset, orgID)
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(permissions) != test.count {
+				t.Fatalf("expected %d permissions, got %d", test.count, len(permissions))
+			}
+
+			for _, perm := range permissions {
+				res := perm.Resource
+				switch {
+				case !test.scoped:
+					if res.OrgID != nil || res.Id != nil {
+						t.Errorf("expected an unscoped %s permission", res.Type)
+					}
+				case res.Type == domain.ResourceTypeOrgs:
+					if res.Id == nil || *res.Id != orgID {
+						t.Errorf("expected the orgs permission to target organization %s", orgID)
+					}
+				case res.Type == domain.ResourceTypeInstance:
+					t.Errorf("unexpected instance permission")
+				default:
+					if res.OrgID == nil || *res.OrgID != orgID {
+						t.Errorf("expected the %s permission to be scoped to organization %s", res.Type, orgID)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSamePermissions(t *testing.T) {
+	bucketID, orgID := "0b5e7f9c3a2d4680", "94d518926178fea7"
+	read := permissionEntry{action: "read", resourceType: "buckets", id: bucketID, orgID: orgID}
+
+	tests := map[string]struct {
+		a        []permissionEntry
+		b        []permissionEntry
+		expected bool
+	}{
+		"equal": {
+			a:        []permissionEntry{read},
+			b:        []permissionEntry{read},
+			expected: true,
+		},
+		"ordering": {
+			a:        []permissionEntry{read, {action: "write", resourceType: "buckets", id: bucketID, orgID: orgID}},
+			b:        []permissionEntry{{action: "write", resourceType: "buckets", id: bucketID, orgID: orgID}, read},
+			expected: true,
+		},
+		"name filled in by the server": {
+			a:        []permissionEntry{read},
+			b:        []permissionEntry{{action: "read", resourceType: "buckets", id: bucketID, orgID: orgID, name: "telegraf", org: "company"}},
+			expected: true,
+		},
+		"organization by name": {
+			a:        []permissionEntry{{action: "read", resourceType: "buckets", id: bucketID, org: "company"}},
+			b:        []permissionEntry{{action: "read", resourceType: "buckets", id: bucketID, orgID: orgID, org: "company"}},
+			expected: true,
+		},
+		"different action": {
+			a:        []permissionEntry{read},
+			b:        []permissionEntry{{action: "write", resourceType: "buckets", id: bucketID, orgID: orgID}},
+			expected: false,
+		},
+		"different organization": {
+			a:        []permissionEntry{read},
+			b:        []permissionEntry{{action: "read", resourceType: "buckets", id: bucketID, orgID: "0000000000000001"}},
+			expected: false,
+		},
+		"different name": {
+			a:        []permissionEntry{{action: "read", resourceType: "buckets", id: bucketID, orgID: orgID, name: "telegraf"}},
+			b:        []permissionEntry{{action: "read", resourceType: "buckets", id: bucketID, orgID: orgID, name: "metrics"}},
+			expected: false,
+		},
+		"extra permission": {
+			a:        []permissionEntry{read},
+			b:        []permissionEntry{read, read},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := samePermissions(test.a, test.b); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPermissionEntriesFromSet(t *testing.T) {
+	ctx := context.Background()
+	bucketID, orgID := "0b5e7f9c3a2d4680", "94d518926178fea7"
+
+	perms := testPermissionsSet(t, testResourceModel(bucketID, "", "", orgID, "buckets"))
+	entries, known, err := permissionEntriesFromSet(ctx, perms)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !known {
+		t.Fatal("expected known permissions")
+	}
+
+	domainPerms, err := convertPermissionsToDomain(ctx, perms)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !samePermissions(entries, permissionEntriesFromDomain(domainPerms)) {
+		t.Errorf("expected the flattened blocks to match their domain permissions")
+	}
+
+	unknown := testPermissionsSet(t, ResourceModel{
+		ID:    types.StringUnknown(),
+		Name:  types.StringValue(""),
+		Org:   types.StringValue(""),
+		OrgID: types.StringValue(orgID),
+		Type:  types.StringValue("buckets"),
+	})
+	if _, known, err := permissionEntriesFromSet(ctx, unknown); err != nil || known {
+		t.Errorf("expected unknown permissions, got known %t and error %v", known, err)
+	}
+}
+
+func testResourceModel(id, name, org, orgID, resourceType string) ResourceModel {
+	return ResourceModel{
+		ID:    types.StringValue(id),
+		Name:  types.StringValue(name),
+		Org:   types.StringValue(org),
+		OrgID: types.StringValue(orgID),
+		Type:  types.StringValue(resourceType),
+	}
+}
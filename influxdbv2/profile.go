@@ -0,0 +1,125 @@
+package influxdbv2
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// cliProfile holds the connection settings of a profile of the InfluxDB CLI
+// configuration file.
+type cliProfile struct {
+	url   string
+	token string
+	org   string
+}
+
+// Helper function to locate the configuration file of the InfluxDB CLI,
+// ~/.influxdbv2/configs by default
+func defaultCLIConfigFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".influxdbv2", "configs"), nil
+}
+
+// Helper function to read a named profile from the InfluxDB CLI configuration
+// file
+func readCLIProfile(name, profile string) (*cliProfile, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles, err := parseCLIConfig(bufio.NewScanner(file))
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %w", name, err)
+	}
+
+	result, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in %s", profile, name)
+	}
+
+	return result, nil
+}
+
+// Helper function to parse the profiles of the InfluxDB CLI configuration
+// file. It is a TOML file of one table per profile holding plain key/value
+// pairs, which is all that is supported here. Unknown keys, such as active
+// or previous, are ignored.
+func parseCLIConfig(scanner *bufio.Scanner) (map[string]*cliProfile, error) {
+	profiles := map[string]*cliProfile{}
+
+	var current *cliProfile
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid profile header", lineNumber)
+			}
+			name, err := cliConfigValue(strings.TrimSpace(line[1 : len(line)-1]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			current = &cliProfile{}
+			profiles[name] = current
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected a key = value pair", lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile", lineNumber)
+		}
+
+		parsed, err := cliConfigValue(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		switch strings.TrimSpace(key) {
+		case "url":
+			current.url = parsed
+		case "token":
+			current.token = parsed
+		case "org":
+			current.org = parsed
+		}
+	}
+
+	return profiles, scanner.Err()
+}
+
+// Helper function to decode a TOML string, or return a bare value such as a
+// boolean as is
+func cliConfigValue(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		// Drop a trailing comment after the closing quote
+		if end := strings.LastIndex(value, `"`); end > 0 {
+			value = value[:end+1]
+		}
+		return strconv.Unquote(value)
+	case strings.HasPrefix(value, "'"):
+		end := strings.Index(value[1:], "'")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated string %s", value)
+		}
+		return value[1 : end+1], nil
+	default:
+		value, _, _ = strings.Cut(value, "#")
+		return strings.TrimSpace(value), nil
+	}
+}
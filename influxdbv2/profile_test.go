@@ -0,0 +1,89 @@
+package influxdbv2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testCLIConfig = `[default]
+  url = "http://localhost:8086"
+  token = "local-token"
+  org = "company"
+  active = true
+
+# Production instance
+[production]
+  url = "https://influxdb.example.com" # behind the proxy
+  token = 'prod-token'
+  org = "company"
+  previous = true
+`
+
+func TestReadCLIProfile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "configs")
+	if err := os.WriteFile(name, []byte(testCLIConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		profile  string
+		expected cliProfile
+		error    bool
+	}{
+		{
+			profile:  "default",
+			expected: cliProfile{url: "http://localhost:8086", token: "local-token", org: "company"},
+		},
+		{
+			profile:  "production",
+			expected: cliProfile{url: "https://influxdb.example.com", token: "prod-token", org: "company"},
+		},
+		{
+			profile: "staging",
+			error:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.profile, func(t *testing.T) {
+			profile, err := readCLIProfile(name, test.profile)
+			if test.error {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if *profile != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, *profile)
+			}
+		})
+	}
+
+	if _, err := readCLIProfile(filepath.Join(t.TempDir(), "missing"), "default"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestReadCLIProfile_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"key outside of a profile": "url = \"http://localhost:8086\"\n",
+		"unterminated header":      "[default\n",
+		"missing value":            "[default]\n  url\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "configs")
+			if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := readCLIProfile(file, "default"); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -2,17 +2,36 @@ package influxdbv2
 
 import (
 	"context"
+	"crypto/tls"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
+// logLevels maps the log_level attribute values to InfluxDB client log levels.
+var logLevels = map[string]uint{
+	"error": 0,
+	"warn":  1,
+	"info":  2,
+	"debug": 3,
+}
+
+// defaultLogLevel is used when the log_level attribute is not set.
+const defaultLogLevel = "info"
+
+// defaultMaxRetries is the number of retries of throttled requests when the
+// max_retries attribute is not set.
+const defaultMaxRetries = 3
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ provider.Provider = &influxdbProvider{}
@@ -34,10 +53,57 @@ type influxdbProvider struct {
 	version string
 }
 
+// influxdbProviderData is made available to data sources and resources
+// through their Configure methods.
+type influxdbProviderData struct {
+	client influxdb2.Client
+
+	// url is the resolved InfluxDB server URL.
+	url string
+	// tokenSource describes where the token was taken from, never its value.
+	tokenSource string
+	// orgID is the default organization ID, if any.
+	orgID string
+	// orgName is the name of the default organization, empty if unknown.
+	orgName string
+	// edition is the detected InfluxDB edition, 'oss' or 'cloud', empty if unknown.
+	edition string
+	// validateOrg enables checking that organizations exist before creating objects in them.
+	validateOrg bool
+	// resolveOrgNames enables looking up the organization names of permissions read from the server.
+	resolveOrgNames bool
+	// defaultSchemaType is the schema type of buckets not setting one, empty for the server default.
+	defaultSchemaType string
+
+	// authorizations caches authorization listings between resource reads.
+	authorizations *authorizationsCache
+	// organizations caches organization lookups by name and ID.
+	organizations *organizationsCache
+}
+
 // influxdbProviderModel describes the provider data model.
 type influxdbProviderModel struct {
-	URL   types.String `tfsdk:"url"`
-	Token types.String `tfsdk:"token"`
+	URL               types.String  `tfsdk:"url"`
+	APIPathPrefix     types.String  `tfsdk:"api_path_prefix"`
+	Token             types.String  `tfsdk:"token"`
+	TokenFile         types.String  `tfsdk:"token_file"`
+	Username          types.String  `tfsdk:"username"`
+	Password          types.String  `tfsdk:"password"`
+	OrgID             types.String  `tfsdk:"org_id"`
+	TraceID           types.String  `tfsdk:"trace_id"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	LogLevel          types.String  `tfsdk:"log_level"`
+	ValidateOrg       types.Bool    `tfsdk:"validate_org"`
+	ResolveOrgNames   types.Bool    `tfsdk:"resolve_org_names"`
+	DisableCache      types.Bool    `tfsdk:"disable_cache"`
+	DefaultSchemaType types.String  `tfsdk:"default_schema_type"`
+	ClientCert        types.String  `tfsdk:"client_cert"`
+	ClientKey         types.String  `tfsdk:"client_key"`
+	MaxIdleConns      types.Int64   `tfsdk:"max_idle_conns"`
+	MaxConnsPerHost   types.Int64   `tfsdk:"max_conns_per_host"`
+	Profile           types.String  `tfsdk:"profile"`
+	ConfigFile        types.String  `tfsdk:"config_file"`
 }
 
 // Metadata returns the provider type name.
@@ -55,11 +121,103 @@ func (p *influxdbProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Description: "InfluxDB server URL. Can also be set via INFLUXDB_V2_URL environment variable.",
 				Optional:    true,
 			},
+			"api_path_prefix": schema.StringAttribute{
+				Description: "Path prefix under which InfluxDB is served, such as '/influxdb' behind a reverse proxy. " +
+					"Joined onto url for every request. Can also be set via INFLUXDB_V2_API_PATH_PREFIX environment variable.",
+				Optional: true,
+			},
 			"token": schema.StringAttribute{
 				Description: "InfluxDB authentication token. Can also be set via INFLUXDB_V2_TOKEN environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"token_file": schema.StringAttribute{
+				Description: "Path of a file containing the InfluxDB authentication token. Used when token is not set. " +
+					"Can also be set via INFLUXDB_V2_TOKEN_FILE environment variable.",
+				Optional: true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username to sign in with, together with password. Used when neither token nor token_file is set. " +
+					"Can also be set via INFLUXDB_V2_USERNAME environment variable.",
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password to sign in with, together with username. Can also be set via INFLUXDB_V2_PASSWORD environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Default organization ID. Can also be set via INFLUXDB_V2_ORG_ID environment variable.",
+				Optional:    true,
+			},
+			"trace_id": schema.StringAttribute{
+				Description: "Trace ID sent with every request in the Zap-Trace-Span header, to correlate provider requests " +
+					"with InfluxDB server logs. Can also be set via INFLUXDB_V2_TRACE_ID environment variable. Disabled by default.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to InfluxDB, shared by all operations. Unlimited by default.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request rejected with 429 Too Many Requests is retried after the " +
+					"delay given in its Retry-After header. Defaults to 3, set to 0 to disable retries.",
+				Optional: true,
+			},
+			"log_level": schema.StringAttribute{
+				Description: "Log level of the InfluxDB client, 'error', 'warn', 'info' or 'debug'. At 'debug', the method, " +
+					"path, status and duration of every request are logged. Defaults to 'info'.",
+				Optional: true,
+			},
+			"validate_org": schema.BoolAttribute{
+				Description: "Check that the org_id of a bucket exists before creating it, to report a mistyped ID " +
+					"before any change is made. Disabled by default to avoid the extra request.",
+				Optional: true,
+			},
+			"resolve_org_names": schema.BoolAttribute{
+				Description: "Look up the organization names of authorization permissions read from the server, such as on import, " +
+					"so that they match configurations setting org. Lookups are cached. Enabled by default, disable it to avoid the extra requests.",
+				Optional: true,
+			},
+			"disable_cache": schema.BoolAttribute{
+				Description: "Send every organization lookup and authorization listing to InfluxDB instead of reusing earlier results. " +
+					"Useful to debug stale results. Disabled by default.",
+				Optional: true,
+			},
+			"default_schema_type": schema.StringAttribute{
+				Description: "Schema type of the buckets not setting schema_type, 'implicit' or 'explicit'. " +
+					"Defaults to the server default.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "Client certificate for mutual TLS, as PEM content or the path of a PEM file. Requires client_key. " +
+					"Can also be set via INFLUXDB_V2_CLIENT_CERT environment variable.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "Private key of the client certificate, as PEM content or the path of a PEM file. Requires client_cert. " +
+					"Can also be set via INFLUXDB_V2_CLIENT_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				Description: "Maximum number of idle connections kept open to InfluxDB for reuse. Defaults to 100.",
+				Optional:    true,
+			},
+			"max_conns_per_host": schema.Int64Attribute{
+				Description: "Maximum number of connections open to InfluxDB at once, idle or in use. Unlimited by default.",
+				Optional:    true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of a profile of the InfluxDB CLI configuration file whose url, token and org are used. " +
+					"The url, token and org_id attributes override the profile values. Can also be set via INFLUXDB_V2_PROFILE environment variable.",
+				Optional: true,
+			},
+			"config_file": schema.StringAttribute{
+				Description: "Path of the InfluxDB CLI configuration file read for the profile. Defaults to ~/.influxdbv2/configs. " +
+					"Can also be set via INFLUXDB_V2_CONFIG_FILE environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -76,9 +234,52 @@ func (p *influxdbProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	profileName := os.Getenv("INFLUXDB_V2_PROFILE")
+	if !config.Profile.IsNull() {
+		profileName = config.Profile.ValueString()
+	}
+	configFile := os.Getenv("INFLUXDB_V2_CONFIG_FILE")
+	if !config.ConfigFile.IsNull() {
+		configFile = config.ConfigFile.ValueString()
+	}
+
+	// The profile is explicitly selected, so its values take precedence over
+	// the environment variables but not over the configuration attributes.
+	profile := &cliProfile{}
+	if profileName != "" {
+		if configFile == "" {
+			var err error
+			configFile, err = defaultCLIConfigFile()
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("config_file"),
+					"Missing InfluxDB Config File Configuration",
+					"Could not locate the InfluxDB CLI configuration file, set the config_file attribute: "+err.Error(),
+				)
+				return
+			}
+		}
+
+		tflog.Debug(ctx, "Reading InfluxDB CLI profile", map[string]any{"profile": profileName, "config_file": configFile})
+
+		var err error
+		profile, err = readCLIProfile(configFile, profileName)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Invalid InfluxDB Profile",
+				"Could not read the profile from the InfluxDB CLI configuration file: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 	url := os.Getenv("INFLUXDB_V2_URL")
+	if profile.url != "" {
+		url = profile.url
+	}
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
 	}
@@ -86,9 +287,44 @@ func (p *influxdbProvider) Configure(ctx context.Context, req provider.Configure
 		url = "http://localhost:8086"
 	}
 
-	token := os.Getenv("INFLUXDB_V2_TOKEN")
+	apiPathPrefix := os.Getenv("INFLUXDB_V2_API_PATH_PREFIX")
+	if !config.APIPathPrefix.IsNull() {
+		apiPathPrefix = config.APIPathPrefix.ValueString()
+	}
+
+	auth := providerAuth{
+		token:       os.Getenv("INFLUXDB_V2_TOKEN"),
+		tokenSource: "environment",
+		tokenFile:   os.Getenv("INFLUXDB_V2_TOKEN_FILE"),
+		username:    os.Getenv("INFLUXDB_V2_USERNAME"),
+		password:    os.Getenv("INFLUXDB_V2_PASSWORD"),
+	}
+	if profile.token != "" {
+		auth.token = profile.token
+		auth.tokenSource = "profile"
+	}
 	if !config.Token.IsNull() {
-		token = config.Token.ValueString()
+		auth.token = config.Token.ValueString()
+		auth.tokenSource = "attribute"
+	}
+	if !config.TokenFile.IsNull() {
+		auth.tokenFile = config.TokenFile.ValueString()
+	}
+	if !config.Username.IsNull() {
+		auth.username = config.Username.ValueString()
+	}
+	if !config.Password.IsNull() {
+		auth.password = config.Password.ValueString()
+	}
+
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	if !config.OrgID.IsNull() {
+		orgID = config.OrgID.ValueString()
+	}
+
+	traceID := os.Getenv("INFLUXDB_V2_TRACE_ID")
+	if !config.TraceID.IsNull() {
+		traceID = config.TraceID.ValueString()
 	}
 
 	// If any of the expected configurations are missing, return
@@ -102,13 +338,144 @@ func (p *influxdbProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
-	if token == "" {
-		resp.Diagnostics.AddError(
-			"Missing InfluxDB Token Configuration",
-			"While configuring the provider, the InfluxDB token was not found in "+
-				"the INFLUXDB_V2_TOKEN environment variable or provider "+
-				"configuration block token attribute.",
+	authMethod, diags := auth.method()
+	resp.Diagnostics.Append(diags...)
+
+	token := auth.token
+	tokenSource := auth.tokenSource
+	switch authMethod {
+	case authMethodTokenFile:
+		var err error
+		token, err = readTokenFile(auth.tokenFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Invalid InfluxDB Token File",
+				"Could not read the token file: "+err.Error(),
+			)
+		} else if token == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Invalid InfluxDB Token File",
+				"The token file "+auth.tokenFile+" is empty.",
+			)
+		}
+		tokenSource = authMethodTokenFile
+	case authMethodPassword:
+		// The client signs in with a session cookie instead of a token.
+		token = ""
+		tokenSource = authMethodPassword
+	}
+
+	if apiPathPrefix != "" {
+		if !strings.HasPrefix(apiPathPrefix, "/") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_path_prefix"),
+				"Invalid InfluxDB API Path Prefix",
+				"The api_path_prefix attribute must start with '/', got: "+apiPathPrefix,
+			)
+		} else {
+			url = joinAPIPathPrefix(url, apiPathPrefix)
+		}
+	}
+
+	maxRetries := int64(defaultMaxRetries)
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid InfluxDB Max Retries",
+			"The max_retries attribute must not be negative.",
+		)
+	}
+
+	if config.MaxIdleConns.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_idle_conns"),
+			"Invalid InfluxDB Max Idle Connections",
+			"The max_idle_conns attribute must not be negative.",
+		)
+	}
+
+	if config.MaxConnsPerHost.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_conns_per_host"),
+			"Invalid InfluxDB Max Connections Per Host",
+			"The max_conns_per_host attribute must not be negative.",
+		)
+	}
+
+	if config.RequestsPerSecond.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid InfluxDB Request Rate",
+			"The requests_per_second attribute must be a positive number.",
+		)
+	}
+
+	logLevel := defaultLogLevel
+	if !config.LogLevel.IsNull() {
+		logLevel = config.LogLevel.ValueString()
+	}
+
+	if _, ok := logLevels[logLevel]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("log_level"),
+			"Invalid InfluxDB Log Level",
+			"The log_level attribute must be one of 'error', 'warn', 'info' or 'debug', got: "+logLevel,
+		)
+	}
+
+	defaultSchemaType := config.DefaultSchemaType.ValueString()
+	switch domain.SchemaType(defaultSchemaType) {
+	case "", domain.SchemaTypeImplicit, domain.SchemaTypeExplicit:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_schema_type"),
+			"Invalid InfluxDB Default Schema Type",
+			"The default_schema_type attribute must be either 'implicit' or 'explicit', got: "+defaultSchemaType,
+		)
+	}
+
+	clientCert := os.Getenv("INFLUXDB_V2_CLIENT_CERT")
+	if !config.ClientCert.IsNull() {
+		clientCert = config.ClientCert.ValueString()
+	}
+	clientKey := os.Getenv("INFLUXDB_V2_CLIENT_KEY")
+	if !config.ClientKey.IsNull() {
+		clientKey = config.ClientKey.ValueString()
+	}
+
+	var tlsConfig *tls.Config
+	switch {
+	case clientCert != "" && clientKey == "":
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_key"),
+			"Missing InfluxDB Client Key Configuration",
+			"The client_cert attribute is set but the client key was not found in the INFLUXDB_V2_CLIENT_KEY "+
+				"environment variable or provider configuration block client_key attribute.",
+		)
+	case clientKey != "" && clientCert == "":
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cert"),
+			"Missing InfluxDB Client Certificate Configuration",
+			"The client_key attribute is set but the client certificate was not found in the INFLUXDB_V2_CLIENT_CERT "+
+				"environment variable or provider configuration block client_cert attribute.",
 		)
+	case clientCert != "":
+		certificate, err := loadClientCertificate(clientCert, clientKey)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid InfluxDB Client Certificate",
+				"Could not load the client certificate and key: "+err.Error(),
+			)
+		} else {
+			tlsConfig = &tls.Config{Certificates: []tls.Certificate{certificate}}
+		}
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -121,41 +488,197 @@ func (p *influxdbProvider) Configure(ctx context.Context, req provider.Configure
 	tflog.Debug(ctx, "Creating InfluxDB client")
 
 	// Create InfluxDB client
-	opts := influxdb2.DefaultOptions().SetLogLevel(2)
+	opts := influxdb2.DefaultOptions().SetLogLevel(logLevels[logLevel])
+
+	// The TLS configuration must be set before the HTTP client is built,
+	// it then applies to every request including the readiness check.
+	if tlsConfig != nil {
+		tflog.Debug(ctx, "Using a client certificate for mutual TLS")
+		opts.SetTLSConfig(tlsConfig)
+	}
+
+	// Connection limits apply to the transport built by the client, before
+	// it is wrapped by the other transports.
+	if !config.MaxIdleConns.IsNull() || !config.MaxConnsPerHost.IsNull() {
+		tflog.Debug(ctx, "Configuring InfluxDB connection pool", map[string]any{
+			"max_idle_conns":     config.MaxIdleConns.ValueInt64(),
+			"max_conns_per_host": config.MaxConnsPerHost.ValueInt64(),
+		})
+
+		configureConnectionPool(opts.HTTPClient(), config.MaxIdleConns, config.MaxConnsPerHost)
+	}
+
+	// Latency is logged innermost so that it measures the requests alone.
+	if logLevel == "debug" {
+		httpClient := opts.HTTPClient()
+		httpClient.Transport = &latencyLogTransport{next: httpClient.Transport}
+	}
+
+	if traceID != "" {
+		ctx = tflog.SetField(ctx, "trace_id", traceID)
+		tflog.Debug(ctx, "Tracing InfluxDB requests")
+
+		httpClient := opts.HTTPClient()
+		httpClient.Transport = &traceSpanTransport{traceID: traceID, next: httpClient.Transport}
+	}
+
+	if requestsPerSecond := config.RequestsPerSecond.ValueFloat64(); requestsPerSecond > 0 {
+		tflog.Debug(ctx, "Rate limiting InfluxDB requests", map[string]any{"requests_per_second": requestsPerSecond})
+
+		httpClient := opts.HTTPClient()
+		httpClient.Transport = newRateLimitTransport(requestsPerSecond, httpClient.Transport)
+	}
+
+	// Retries are outermost so that every attempt goes through the rate limiter.
+	if maxRetries > 0 {
+		httpClient := opts.HTTPClient()
+		httpClient.Transport = &retryAfterTransport{
+			maxRetries: int(maxRetries),
+			next:       httpClient.Transport,
+		}
+	}
+
 	client := influxdb2.NewClientWithOptions(url, token, opts)
 
-	// Verify connection to InfluxDB
-	ready, err := client.Ready(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Connect to InfluxDB Server",
-			"An unexpected error occurred when connecting to the InfluxDB server. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"InfluxDB Client Error: "+err.Error(),
-		)
-		return
+	if authMethod == authMethodPassword {
+		tflog.Debug(ctx, "Signing in to InfluxDB", map[string]any{"username": auth.username})
+
+		if err := client.UsersAPI().SignIn(ctx, auth.username, auth.password); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Sign In to InfluxDB",
+				"Could not sign in with the configured username and password: "+formatAPIError(err),
+			)
+			return
+		}
 	}
 
-	if ready == nil || ready.Status == nil {
-		resp.Diagnostics.AddError(
+	// Verify connection to InfluxDB. The server may be started in the same
+	// apply and waited for with the ready gate or the wait_healthy data
+	// source, so a server that is not ready only skips the lookups below.
+	status := "unreachable"
+	ready, err := client.Ready(ctx)
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddWarning(
 			"InfluxDB Server Not Ready",
-			"The InfluxDB server is not ready to accept connections.",
+			"Could not connect to the InfluxDB server, the default organization and the edition are not checked. "+
+				"Requests fail until the server is ready.\n\n"+
+				"InfluxDB Client Error: "+formatAPIError(err),
 		)
-		return
+	case ready == nil || ready.Status == nil:
+		resp.Diagnostics.AddWarning(
+			"InfluxDB Server Not Ready",
+			"The InfluxDB server is not ready to accept connections, the default organization and the edition are not checked.",
+		)
+	default:
+		status = string(*ready.Status)
 	}
+	reachable := status != "unreachable"
 
-	tflog.Info(ctx, "InfluxDB client configured successfully", map[string]any{"status": string(*ready.Status)})
+	organizations := newOrganizationsCache()
+	authorizationsTTL := authorizationsCacheTTL
+	if config.DisableCache.ValueBool() {
+		tflog.Debug(ctx, "Disabling the organization and authorization caches")
+
+		organizations.disabled = true
+		authorizationsTTL = 0
+	}
+
+	// The profile names its organization, which is resolved to the default
+	// organization ID unless one is configured.
+	var orgName string
+	if reachable && orgID == "" && profile.org != "" {
+		org, err := organizations.findByName(ctx, profile.org, func(ctx context.Context) (*domain.Organization, error) {
+			return client.OrganizationsAPI().FindOrganizationByName(ctx, profile.org)
+		})
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("profile"),
+				"Unresolved InfluxDB Profile Organization",
+				"Could not find the organization "+profile.org+" of profile "+profileName+", no default organization is set: "+formatAPIError(err),
+			)
+		} else if org.Id != nil {
+			orgID = *org.Id
+			orgName = org.Name
+		}
+	} else if reachable && orgID != "" {
+		// A mistyped default organization is reported before any change is
+		// made. Tokens may not be allowed to read organizations, so other
+		// errors only leave the name unknown.
+		org, err := organizations.findByID(ctx, orgID, func(ctx context.Context) (*domain.Organization, error) {
+			return client.OrganizationsAPI().FindOrganizationByID(ctx, orgID)
+		})
+		switch {
+		case isNotFoundError(err):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("org_id"),
+				"Invalid InfluxDB Organization ID",
+				"The default organization ID "+orgID+" does not exist. Check the org_id attribute or the INFLUXDB_V2_ORG_ID environment variable.",
+			)
+			return
+		case err != nil:
+			tflog.Debug(ctx, "Could not resolve the default organization name", map[string]any{"org_id": orgID, "error": formatAPIError(err)})
+		default:
+			orgName = org.Name
+		}
+	}
+
+	// Edition-specific resources use the edition to fail early. It is not
+	// required, so detection errors are only logged.
+	var edition string
+	if reachable {
+		edition, err = detectEdition(ctx, client)
+		if err != nil {
+			tflog.Debug(ctx, "Could not detect the InfluxDB edition", map[string]any{"error": formatAPIError(err)})
+		}
+	}
+
+	tflog.Info(ctx, "InfluxDB client configured successfully", map[string]any{"status": status, "edition": edition})
 
 	// Make the InfluxDB client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	providerData := &influxdbProviderData{
+		client:      client,
+		url:         url,
+		tokenSource: tokenSource,
+		orgID:       orgID,
+		orgName:     orgName,
+		edition:     edition,
+		validateOrg: config.ValidateOrg.ValueBool(),
+
+		resolveOrgNames: config.ResolveOrgNames.IsNull() || config.ResolveOrgNames.ValueBool(),
+
+		defaultSchemaType: defaultSchemaType,
+
+		authorizations: newAuthorizationsCache(authorizationsTTL),
+		organizations:  organizations,
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+}
+
+// Helper function to serve every request, including the readiness check,
+// under the path prefix
+func joinAPIPathPrefix(url, prefix string) string {
+	return strings.TrimSuffix(url, "/") + "/" + strings.Trim(prefix, "/")
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *influxdbProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewReadyDataSource,
+		NewBucketsDataSource,
+		NewProviderConfigDataSource,
+		NewWaitHealthyDataSource,
+		NewFeaturesDataSource,
+		NewImportBlocksDataSource,
+		NewOrganizationsDataSource,
+		NewTaskRunsDataSource,
+		NewCheckDataSource,
+		NewMeasurementSchemasDataSource,
+		NewOnboardingDataSource,
+		NewBucketDataSource,
+		NewVariableDataSource,
 	}
 }
 
@@ -164,5 +687,11 @@ func (p *influxdbProvider) Resources(_ context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		NewBucketResource,
 		NewAuthorizationResource,
+		NewSecretsResource,
+		NewLabelAttachmentResource,
+		NewOrganizationOwnerResource,
+		NewBucketsResource,
+		NewReadyGateResource,
+		NewStackResource,
 	}
 }
@@ -1,11 +1,21 @@
 package influxdbv2
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -28,3 +38,90 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("INFLUXDB_V2_ORG_ID must be set for acceptance tests")
 	}
 }
+
+// Helper function to build a client for test steps changing InfluxDB outside
+// of Terraform. It is closed at the end of the test.
+func testAccClient(t *testing.T) influxdb2.Client {
+	client := influxdb2.NewClient(os.Getenv("INFLUXDB_V2_URL"), os.Getenv("INFLUXDB_V2_TOKEN"))
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestJoinAPIPathPrefix(t *testing.T) {
+	tests := []struct {
+		url      string
+		prefix   string
+		expected string
+	}{
+		{url: "http://localhost:8086", prefix: "/influxdb", expected: "http://localhost:8086/influxdb"},
+		{url: "http://localhost:8086/", prefix: "/influxdb/", expected: "http://localhost:8086/influxdb"},
+		{url: "https://example.com/base", prefix: "/influxdb/v2", expected: "https://example.com/base/influxdb/v2"},
+	}
+
+	for _, test := range tests {
+		if actual := joinAPIPathPrefix(test.url, test.prefix); actual != test.expected {
+			t.Errorf("joinAPIPathPrefix(%q, %q) = %q; expected %q", test.url, test.prefix, actual, test.expected)
+		}
+	}
+}
+
+func TestProviderConfigure_ServerNotReady(t *testing.T) {
+	var ready atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ready" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":"ready","started":"2024-01-01T00:00:00Z","up":"1s"}`)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["url"] = tftypes.NewValue(tftypes.String, server.URL)
+	values["token"] = tftypes.NewValue(tftypes.String, "token")
+	values["org_id"] = tftypes.NewValue(tftypes.String, "94d518926178fea7")
+	values["max_retries"] = tftypes.NewValue(tftypes.Number, 0)
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected the provider to be configured, got: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected a not ready warning, got: %v", resp.Diagnostics)
+	}
+
+	providerData, ok := resp.ResourceData.(*influxdbProviderData)
+	if !ok {
+		t.Fatalf("expected provider data, got %T", resp.ResourceData)
+	}
+	if providerData.edition != "" {
+		t.Errorf("expected the edition not to be detected, got %q", providerData.edition)
+	}
+
+	// The ready gate can then wait for the server with the configured client.
+	time.AfterFunc(50*time.Millisecond, func() { ready.Store(true) })
+	if _, err := waitForReady(ctx, providerData.client, 5*time.Second, 10*time.Millisecond); err != nil {
+		t.Errorf("expected the server to become ready, got: %s", err)
+	}
+}
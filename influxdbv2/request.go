@@ -0,0 +1,49 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// Helper function to send a JSON request to an endpoint that the client does
+// not cover, or does not serialize correctly. The path is relative to the
+// /api/v2/ base URL. The body is sent and the result decoded when not nil.
+func doAPIRequest(ctx context.Context, client influxdb2.Client, method, apiPath string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	service := client.HTTPService()
+	req, err := http.NewRequestWithContext(ctx, method, service.ServerAPIURL()+apiPath, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	// The service returns a typed nil pointer on success, which must not be
+	// returned as a non-nil error.
+	if herr := service.DoHTTPRequest(req, nil, func(resp *http.Response) error {
+		defer resp.Body.Close()
+		if result == nil {
+			_, err := io.Copy(io.Discard, resp.Body)
+			return err
+		}
+		return json.NewDecoder(resp.Body).Decode(result)
+	}); herr != nil {
+		return herr
+	}
+
+	return nil
+}
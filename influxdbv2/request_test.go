@@ -0,0 +1,56 @@
+package influxdbv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	influxhttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+)
+
+func TestDoAPIRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token my-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/api/v2/echo":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"method": r.Method, "key": body["key"]})
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": "not found", "message": "path not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "my-token")
+	defer client.Close()
+
+	var result map[string]string
+	err := doAPIRequest(context.Background(), client, http.MethodPatch, "echo", map[string]string{"key": "value"}, &result)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result["method"] != http.MethodPatch || result["key"] != "value" {
+		t.Errorf("unexpected result: %v", result)
+	}
+
+	err = doAPIRequest(context.Background(), client, http.MethodGet, "missing", nil, nil)
+	var apiErr *influxhttp.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a not found API error, got %v", err)
+	}
+}
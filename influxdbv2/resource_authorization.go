@@ -1,14 +1,21 @@
 package influxdbv2
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,9 +24,16 @@ import (
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
+// Deletion policies of the authorization resource.
+const (
+	authorizationDeletionPolicyDelete     = "delete"
+	authorizationDeletionPolicyDeactivate = "deactivate"
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AuthorizationResource{}
 var _ resource.ResourceWithImportState = &AuthorizationResource{}
+var _ resource.ResourceWithValidateConfig = &AuthorizationResource{}
 
 func NewAuthorizationResource() resource.Resource {
 	return &AuthorizationResource{}
@@ -27,33 +41,32 @@ func NewAuthorizationResource() resource.Resource {
 
 // AuthorizationResource defines the resource implementation.
 type AuthorizationResource struct {
-	client influxdb2.Client
+	client         influxdb2.Client
+	authorizations *authorizationsCache
+	organizations  *organizationsCache
+
+	resolveOrgNames bool
 }
 
 // AuthorizationResourceModel describes the resource data model.
 type AuthorizationResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	OrgID       types.String `tfsdk:"org_id"`
-	Description types.String `tfsdk:"description"`
-	Status      types.String `tfsdk:"status"`
-	Permissions types.Set    `tfsdk:"permissions"`
-	UserID      types.String `tfsdk:"user_id"`
-	UserOrgID   types.String `tfsdk:"user_org_id"`
-	Token       types.String `tfsdk:"token"`
-}
-
-// PermissionModel describes the permission data model.
-type PermissionModel struct {
-	Action   types.String `tfsdk:"action"`
-	Resource types.Set    `tfsdk:"resource"`
-}
-
-// ResourceModel describes the resource data model.
-type ResourceModel struct {
-	ID    types.String `tfsdk:"id"`
-	Org   types.String `tfsdk:"org"`
-	OrgID types.String `tfsdk:"org_id"`
-	Type  types.String `tfsdk:"type"`
+	ID              types.String   `tfsdk:"id"`
+	OrgID           types.String   `tfsdk:"org_id"`
+	Description     types.String   `tfsdk:"description"`
+	Status          types.String   `tfsdk:"status"`
+	Permissions     types.Set      `tfsdk:"permissions"`
+	PermissionsJSON types.String   `tfsdk:"permissions_json"`
+	Preset          types.String   `tfsdk:"preset"`
+	PermissionCount types.Int64    `tfsdk:"permission_count"`
+	UserID          types.String   `tfsdk:"user_id"`
+	UserOrgID       types.String   `tfsdk:"user_org_id"`
+	OwnerOrgID      types.String   `tfsdk:"owner_org_id"`
+	Token           types.String   `tfsdk:"token"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+	RotationTrigger types.String   `tfsdk:"rotation_trigger"`
+	DeletionPolicy  types.String   `tfsdk:"deletion_policy"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *AuthorizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,8 +85,9 @@ func (r *AuthorizationResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"org_id": schema.StringAttribute{
-				Description: "The organization ID.",
-				Required:    true,
+				Description: "The home organization ID of the authorization, in which the token is created. " +
+					"The organizations the token grants access to are given by the org_id of each permission resource.",
+				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -98,12 +112,20 @@ func (r *AuthorizationResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"user_org_id": schema.StringAttribute{
-				Description: "The organization ID of the user.",
+				Description: "The organization ID of the authorization, as reported by InfluxDB.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"owner_org_id": schema.StringAttribute{
+				Description: "The organization owning the token, as reported by InfluxDB. It is the home organization " +
+					"even when the permissions grant access to resources of other organizations.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"token": schema.StringAttribute{
 				Description: "The authorization token. This is sensitive and should be stored securely.",
 				Computed:    true,
@@ -112,10 +134,76 @@ func (r *AuthorizationResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"permissions_json": schema.StringAttribute{
+				Description: "JSON-encoded array of permissions, as accepted by the InfluxDB API. " +
+					"Alternative to the permissions blocks, which must not be set at the same time.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preset": schema.StringAttribute{
+				Description: "Generates the permissions of a token preset, 'all_access' for every resource of the " +
+					"organization or 'operator' for every resource of the instance. Alternative to the permissions " +
+					"blocks and permissions_json, which must not be set at the same time.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_count": schema.Int64Attribute{
+				Description: "The number of permissions granted by the token. A permission block listing several " +
+					"resources counts once per resource.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The RFC3339 timestamp when the authorization was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The RFC3339 timestamp when the authorization was last updated.",
+				Computed:    true,
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Description: "Arbitrary value whose changes replace the authorization with a new token. " +
+					"Setting it on an existing authorization does not replace it.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the rotation trigger replaces the authorization with a new token.",
+						"Changing the rotation trigger replaces the authorization with a new token.",
+					),
+				},
+			},
+			"deletion_policy": schema.StringAttribute{
+				Description: "What happens to the token when the authorization is destroyed, 'delete' or 'deactivate'. " +
+					"Deactivated tokens are kept in InfluxDB with an inactive status. Defaults to 'delete'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(authorizationDeletionPolicyDelete),
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
 			"permissions": schema.SetNestedBlock{
-				Description: "List of permissions for the authorization.",
+				Description: "List of permissions for the authorization. InfluxDB cannot change the permissions of a " +
+					"token, so changing them replaces the authorization with a new token.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(
+						permissionsRequireReplace,
+						"Changing the permissions replaces the authorization with a new token.",
+						"Changing the permissions replaces the authorization with a new token.",
+					),
+				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -132,15 +220,22 @@ func (r *AuthorizationResource) Schema(ctx context.Context, req resource.SchemaR
 										Description: "Resource ID.",
 										Required:    true,
 									},
+									"name": schema.StringAttribute{
+										Description: "Resource name.",
+										Optional:    true,
+										Computed:    true,
+										Default:     stringdefault.StaticString(""),
+									},
 									"org": schema.StringAttribute{
-										Description: "Organization name.",
+										Description: "Organization name. When org_id is not set, it is resolved from this name.",
 										Optional:    true,
 										Computed:    true,
 										Default:     stringdefault.StaticString(""),
 									},
 									"org_id": schema.StringAttribute{
-										Description: "Organization ID.",
-										Required:    true,
+										Description: "Organization ID. Exactly one of org or org_id must be set.",
+										Optional:    true,
+										Computed:    true,
 									},
 									"type": schema.StringAttribute{
 										Description: "Resource type (e.g., 'buckets', 'dashboards').",
@@ -161,16 +256,83 @@ func (r *AuthorizationResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(influxdb2.Client)
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.authorizations = providerData.authorizations
+	r.organizations = providerData.organizations
+	r.resolveOrgNames = providerData.resolveOrgNames
+}
+
+func (r *AuthorizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AuthorizationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.DeletionPolicy.IsNull() && !config.DeletionPolicy.IsUnknown() {
+		switch config.DeletionPolicy.ValueString() {
+		case authorizationDeletionPolicyDelete, authorizationDeletionPolicyDeactivate:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deletion_policy"),
+				"Invalid Deletion Policy",
+				"The deletion_policy must be either 'delete' or 'deactivate', got: "+config.DeletionPolicy.ValueString(),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(validatePermissionOrgs(ctx, config.Permissions)...)
+	resp.Diagnostics.Append(validatePermissionIDs(ctx, config.Permissions)...)
+
+	if !config.Preset.IsNull() && !config.Preset.IsUnknown() {
+		switch config.Preset.ValueString() {
+		case authorizationPresetAllAccess, authorizationPresetOperator:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("preset"),
+				"Invalid Preset",
+				"The preset must be either 'all_access' or 'operator', got: "+config.Preset.ValueString(),
+			)
+		}
+
+		if len(config.Permissions.Elements()) > 0 || !config.PermissionsJSON.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("preset"),
+				"Conflicting Permissions Configuration",
+				"The preset cannot be set with permissions_json or permissions blocks.",
+			)
+		}
+	}
+
+	if config.PermissionsJSON.IsNull() || config.PermissionsJSON.IsUnknown() {
+		return
+	}
+
+	if len(config.Permissions.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions_json"),
+			"Conflicting Permissions Configuration",
+			"Only one of permissions_json or permissions blocks may be set.",
+		)
+	}
+
+	if _, err := parsePermissionsJSON(config.PermissionsJSON.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("permissions_json"),
+			"Invalid Permissions JSON",
+			"Could not parse permissions_json: "+err.Error(),
+		)
+	}
 }
 
 func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -181,8 +343,36 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Resolve the organizations given by name
+	resolved, err := r.resolvePermissionOrgIDs(ctx, plan.Permissions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resolving Permission Organization",
+			"Could not resolve permission organization: "+formatAPIError(err),
+		)
+		return
+	}
+	plan.Permissions = resolved
+
 	// Convert permissions from Terraform data to domain model
-	permissions, err := r.convertPermissionsToDomain(ctx, plan.Permissions)
+	var permissions []domain.Permission
+	switch {
+	case !plan.Preset.IsNull():
+		permissions, err = presetPermissions(plan.Preset.ValueString(), plan.OrgID.ValueString())
+	case !plan.PermissionsJSON.IsNull():
+		permissions, err = parsePermissionsJSON(plan.PermissionsJSON.ValueString())
+	default:
+		permissions, err = convertPermissionsToDomain(ctx, plan.Permissions)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Converting Permissions",
@@ -193,7 +383,8 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 
 	tflog.Debug(ctx, "Creating authorization", map[string]any{"permissions_count": len(permissions)})
 
-	// Create authorization
+	// Create authorization. The create request accepts the status, so tokens
+	// configured as inactive are never active.
 	orgID := plan.OrgID.ValueString()
 	desc := plan.Description.ValueString()
 	status := domain.AuthorizationUpdateRequestStatus(plan.Status.ValueString())
@@ -208,16 +399,20 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	result, err := r.client.AuthorizationsAPI().CreateAuthorization(ctx, &authorization)
+	r.authorizations.invalidate(orgID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Authorization",
-			"Could not create authorization: "+err.Error(),
+			"Could not create authorization: "+formatAPIError(err),
 		)
 		return
 	}
 
 	// Set the ID and computed fields
 	plan.ID = types.StringValue(*result.Id)
+	if result.Status != nil {
+		plan.Status = types.StringValue(string(*result.Status))
+	}
 	if result.Token != nil {
 		plan.Token = types.StringValue(*result.Token)
 	}
@@ -226,7 +421,14 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 	}
 	if result.OrgID != nil {
 		plan.UserOrgID = types.StringValue(*result.OrgID)
+		plan.OwnerOrgID = types.StringValue(*result.OrgID)
+	}
+	plan.PermissionCount = types.Int64Value(int64(len(permissions)))
+	if result.Permissions != nil {
+		plan.PermissionCount = types.Int64Value(int64(len(*result.Permissions)))
 	}
+	plan.CreatedAt = timestampValue(result.CreatedAt)
+	plan.UpdatedAt = timestampValue(result.UpdatedAt)
 
 	tflog.Trace(ctx, "Created authorization", map[string]any{"id": plan.ID.ValueString()})
 
@@ -242,11 +444,20 @@ func (r *AuthorizationResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Read the authorization from InfluxDB
 	if err := r.readAuthorization(ctx, &state); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Authorization",
-			"Could not read authorization ID "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read authorization ID "+state.ID.ValueString()+": "+formatAPIError(err),
 		)
 		return
 	}
@@ -263,6 +474,27 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Permissions are kept as planned, organizations given by name still
+	// need their ID in state.
+	resolved, err := r.resolvePermissionOrgIDs(ctx, plan.Permissions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resolving Permission Organization",
+			"Could not resolve permission organization: "+formatAPIError(err),
+		)
+		return
+	}
+	plan.Permissions = resolved
+
 	// Note: Only status can be updated in InfluxDB authorizations
 	id := plan.ID.ValueString()
 	authorization := domain.Authorization{
@@ -272,11 +504,12 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 
 	tflog.Debug(ctx, "Updating authorization status", map[string]any{"id": plan.ID.ValueString(), "status": plan.Status.ValueString()})
 
-	_, err := r.client.AuthorizationsAPI().UpdateAuthorizationStatus(ctx, &authorization, statusUpdate)
+	_, err = r.client.AuthorizationsAPI().UpdateAuthorizationStatus(ctx, &authorization, statusUpdate)
+	r.authorizations.invalidate(plan.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Authorization",
-			"Could not update authorization status: "+err.Error(),
+			"Could not update authorization status: "+formatAPIError(err),
 		)
 		return
 	}
@@ -285,7 +518,7 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 	if err := r.readAuthorization(ctx, &plan); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Authorization After Update",
-			"Could not read authorization after update: "+err.Error(),
+			"Could not read authorization after update: "+formatAPIError(err),
 		)
 		return
 	}
@@ -302,18 +535,45 @@ func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	tflog.Debug(ctx, "Deleting authorization", map[string]any{"id": state.ID.ValueString()})
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
 	id := state.ID.ValueString()
 	authorization := domain.Authorization{
 		Id: &id,
 	}
 
+	if state.DeletionPolicy.ValueString() == authorizationDeletionPolicyDeactivate {
+		tflog.Debug(ctx, "Deactivating authorization", map[string]any{"id": state.ID.ValueString()})
+
+		_, err := r.client.AuthorizationsAPI().UpdateAuthorizationStatus(ctx, &authorization, domain.AuthorizationUpdateRequestStatusInactive)
+		r.authorizations.invalidate(state.OrgID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deactivating Authorization",
+				"Could not deactivate authorization: "+formatAPIError(err),
+			)
+			return
+		}
+
+		tflog.Trace(ctx, "Deactivated authorization", map[string]any{"id": state.ID.ValueString()})
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting authorization", map[string]any{"id": state.ID.ValueString()})
+
 	err := r.client.AuthorizationsAPI().DeleteAuthorization(ctx, &authorization)
+	r.authorizations.invalidate(state.OrgID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Authorization",
-			"Could not delete authorization: "+err.Error(),
+			"Could not delete authorization: "+formatAPIError(err),
 		)
 		return
 	}
@@ -327,17 +587,20 @@ func (r *AuthorizationResource) ImportState(ctx context.Context, req resource.Im
 
 // Helper function to read authorization and populate the model
 func (r *AuthorizationResource) readAuthorization(ctx context.Context, model *AuthorizationResourceModel) error {
-	// Find all authorizations for the org
-	authorizations, err := r.client.AuthorizationsAPI().FindAuthorizationsByOrgID(ctx, model.OrgID.ValueString())
+	// Find all authorizations for the org, shared with the other reads
+	orgID := model.OrgID.ValueString()
+	authorizations, err := r.authorizations.list(ctx, orgID, func(ctx context.Context) (*[]domain.Authorization, error) {
+		return r.client.AuthorizationsAPI().FindAuthorizationsByOrgID(ctx, orgID)
+	})
 	if err != nil {
 		return fmt.Errorf("error finding authorizations: %w", err)
 	}
 
 	// Find the specific authorization by ID
 	var auth *domain.Authorization
-	for i := range *authorizations {
-		if *(*authorizations)[i].Id == model.ID.ValueString() {
-			auth = &(*authorizations)[i]
+	for i := range authorizations {
+		if *authorizations[i].Id == model.ID.ValueString() {
+			auth = &authorizations[i]
 			break
 		}
 	}
@@ -357,130 +620,309 @@ func (r *AuthorizationResource) readAuthorization(ctx context.Context, model *Au
 
 	if auth.OrgID != nil {
 		model.UserOrgID = types.StringValue(*auth.OrgID)
+		model.OwnerOrgID = types.StringValue(*auth.OrgID)
 	}
 
 	if auth.Token != nil {
 		model.Token = types.StringValue(*auth.Token)
 	}
 
-	// Note: Permissions are not returned by the read API, so we keep the plan values
+	if auth.Permissions != nil {
+		model.PermissionCount = types.Int64Value(int64(len(*auth.Permissions)))
+	} else {
+		model.PermissionCount = types.Int64Value(0)
+	}
+
+	model.CreatedAt = timestampValue(auth.CreatedAt)
+	model.UpdatedAt = timestampValue(auth.UpdatedAt)
+
+	// The deletion policy only lives in state, imported authorizations use the default.
+	if model.DeletionPolicy.IsNull() {
+		model.DeletionPolicy = types.StringValue(authorizationDeletionPolicyDelete)
+	}
+
+	// Permissions cannot be updated, so the configured values are kept unless
+	// they differ from the server, in which case the server permissions are
+	// stored for the plan to propose a replacement. The blocks are filled from
+	// the server when no form is known yet, which is the case after an import.
+	// Blocks cannot be computed, so filling them while permissions_json or a
+	// preset is in use would cause a perpetual diff.
+	if model.PermissionsJSON.IsNull() && model.Preset.IsNull() && auth.Permissions != nil {
+		if !model.Permissions.IsNull() {
+			entries, known, err := permissionEntriesFromSet(ctx, model.Permissions)
+			if err != nil {
+				return err
+			}
+			if !known || samePermissions(entries, permissionEntriesFromDomain(*auth.Permissions)) {
+				return nil
+			}
+			tflog.Warn(ctx, "Authorization permissions differ from the server", map[string]any{"id": model.ID.ValueString()})
+		}
+
+		// The listing is shared, resolve the organizations on a copy.
+		domainPermissions := append([]domain.Permission(nil), *auth.Permissions...)
+		r.resolvePermissionOrgs(ctx, domainPermissions)
+		permissions, err := convertPermissionsToTerraform(ctx, domainPermissions)
+		if err != nil {
+			return err
+		}
+		model.Permissions = permissions
+	}
 
 	return nil
 }
 
-// Helper function to convert permissions from Terraform Set to domain model
-func (r *AuthorizationResource) convertPermissionsToDomain(ctx context.Context, permsSet types.Set) ([]domain.Permission, error) {
+// Helper function to replace an authorization whose permissions change. The
+// permissions are compared as flattened sets, so that regrouping resources
+// into blocks, or names filled in by InfluxDB, do not replace the token.
+func permissionsRequireReplace(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	planned, known, err := permissionEntriesFromSet(ctx, req.PlanValue)
+	if err != nil || !known {
+		resp.RequiresReplace = true
+		return
+	}
+	current, known, err := permissionEntriesFromSet(ctx, req.StateValue)
+	if err != nil || !known {
+		resp.RequiresReplace = true
+		return
+	}
+
+	resp.RequiresReplace = !samePermissions(planned, current)
+}
+
+// Helper function to fill in the organization names missing from permission
+// resources, so that imported permissions match configurations setting org.
+// The resolution is best-effort, names that cannot be resolved stay empty,
+// and is skipped when the provider disables it.
+func (r *AuthorizationResource) resolvePermissionOrgs(ctx context.Context, permissions []domain.Permission) {
+	if !r.resolveOrgNames {
+		return
+	}
+
+	for i := range permissions {
+		res := &permissions[i].Resource
+		if res.OrgID == nil || *res.OrgID == "" || (res.Org != nil && *res.Org != "") {
+			continue
+		}
+
+		orgID := *res.OrgID
+		org, err := r.organizations.findByID(ctx, orgID, func(ctx context.Context) (*domain.Organization, error) {
+			return r.client.OrganizationsAPI().FindOrganizationByID(ctx, orgID)
+		})
+		if err != nil {
+			tflog.Debug(ctx, "Could not resolve permission organization name", map[string]any{"org_id": orgID, "error": err.Error()})
+			continue
+		}
+
+		name := org.Name
+		res.Org = &name
+	}
+}
+
+// Helper function to check that each configured permission resource sets org
+// or org_id. Unknown values are checked once known. Both may be set, as
+// required before org names were resolved, and are then checked against each
+// other when applied.
+func validatePermissionOrgs(ctx context.Context, permsSet types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if permsSet.IsNull() || permsSet.IsUnknown() {
+		return diags
+	}
+
 	var permissions []PermissionModel
-	diags := permsSet.ElementsAs(ctx, &permissions, false)
+	diags.Append(permsSet.ElementsAs(ctx, &permissions, false)...)
 	if diags.HasError() {
-		return nil, fmt.Errorf("error converting permissions set")
+		return diags
 	}
 
-	domainPermissions := []domain.Permission{}
 	for _, perm := range permissions {
+		if perm.Resource.IsNull() || perm.Resource.IsUnknown() {
+			continue
+		}
+
 		var resources []ResourceModel
-		diags := perm.Resource.ElementsAs(ctx, &resources, false)
+		diags.Append(perm.Resource.ElementsAs(ctx, &resources, false)...)
 		if diags.HasError() {
-			return nil, fmt.Errorf("error converting resources set")
+			return diags
 		}
 
 		for _, res := range resources {
-			id := res.ID.ValueString()
-			orgID := res.OrgID.ValueString()
-			org := res.Org.ValueString()
-			name := ""
-
-			domainResource := domain.Resource{
-				Type:  domain.ResourceType(res.Type.ValueString()),
-				Id:    &id,
-				OrgID: &orgID,
-				Name:  &name,
-				Org:   &org,
+			if res.Org.IsUnknown() || res.OrgID.IsUnknown() {
+				continue
 			}
 
-			domainPerm := domain.Permission{
-				Action:   domain.PermissionAction(perm.Action.ValueString()),
-				Resource: domainResource,
+			if res.Org.ValueString() == "" && res.OrgID.IsNull() {
+				diags.AddAttributeError(
+					path.Root("permissions"),
+					"Invalid Permission Organization",
+					"One of org or org_id must be set on the "+res.Type.ValueString()+" resource of the "+
+						perm.Action.ValueString()+" permission.",
+				)
 			}
-
-			domainPermissions = append(domainPermissions, domainPerm)
 		}
 	}
 
-	return domainPermissions, nil
+	return diags
 }
 
-// Helper function to convert permissions from domain model to Terraform Set
-func (r *AuthorizationResource) convertPermissionsToTerraform(ctx context.Context, domainPerms []domain.Permission) (types.Set, error) {
-	resourceType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":     types.StringType,
-			"org":    types.StringType,
-			"org_id": types.StringType,
-			"type":   types.StringType,
-		},
+// Helper function to check that the IDs of permission resources look like
+// InfluxDB IDs, so that a bucket name given instead of its ID is reported
+// before the API rejects the authorization. An empty ID targets every resource
+// of the type and is allowed.
+func validatePermissionIDs(ctx context.Context, permsSet types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if permsSet.IsNull() || permsSet.IsUnknown() {
+		return diags
 	}
 
-	permissionType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"action":   types.StringType,
-			"resource": types.SetType{ElemType: resourceType},
-		},
+	var permissions []PermissionModel
+	diags.Append(permsSet.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return diags
 	}
 
-	elements := []attr.Value{}
-	for _, perm := range domainPerms {
-		resourceElements := []attr.Value{}
+	for _, perm := range permissions {
+		if perm.Resource.IsNull() || perm.Resource.IsUnknown() {
+			continue
+		}
 
-		id := ""
-		if perm.Resource.Id != nil {
-			id = *perm.Resource.Id
+		var resources []ResourceModel
+		diags.Append(perm.Resource.ElementsAs(ctx, &resources, false)...)
+		if diags.HasError() {
+			return diags
 		}
-		orgID := ""
-		if perm.Resource.OrgID != nil {
-			orgID = *perm.Resource.OrgID
+
+		for _, res := range resources {
+			if res.ID.IsUnknown() || res.ID.ValueString() == "" || isValidID(res.ID.ValueString()) {
+				continue
+			}
+
+			diags.AddAttributeError(
+				path.Root("permissions"),
+				"Invalid Permission Resource ID",
+				fmt.Sprintf("The id of the %s resource of the %s permission must be a 16 character hexadecimal "+
+					"InfluxDB ID, got %q. Check that the ID of the %s is used rather than its name.",
+					res.Type.ValueString(), perm.Action.ValueString(), res.ID.ValueString(), res.Type.ValueString()),
+			)
 		}
-		org := ""
-		if perm.Resource.Org != nil {
-			org = *perm.Resource.Org
+	}
+
+	return diags
+}
+
+// Helper function to check that an ID has the format of InfluxDB IDs, 16
+// hexadecimal characters
+func isValidID(id string) bool {
+	if len(id) != 16 {
+		return false
+	}
+	for _, c := range id {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
 		}
+	}
+	return true
+}
 
-		resObj, diags := types.ObjectValue(
-			resourceType.AttrTypes,
-			map[string]attr.Value{
-				"id":     types.StringValue(id),
-				"org":    types.StringValue(org),
-				"org_id": types.StringValue(orgID),
-				"type":   types.StringValue(string(perm.Resource.Type)),
-			},
-		)
-		if diags.HasError() {
-			return types.SetNull(permissionType), fmt.Errorf("error creating resource object")
+// Helper function to fill in the org_id of permission resources configured
+// with an organization name, or to check it against the name when both are
+// set. The blocks keep their configured layout.
+func (r *AuthorizationResource) resolvePermissionOrgIDs(ctx context.Context, permsSet types.Set) (types.Set, error) {
+	if permsSet.IsNull() || permsSet.IsUnknown() {
+		return permsSet, nil
+	}
+
+	var permissions []PermissionModel
+	if diags := permsSet.ElementsAs(ctx, &permissions, false); diags.HasError() {
+		return permsSet, fmt.Errorf("error converting permissions set")
+	}
+
+	for i, perm := range permissions {
+		var resources []ResourceModel
+		if diags := perm.Resource.ElementsAs(ctx, &resources, false); diags.HasError() {
+			return permsSet, fmt.Errorf("error converting resources set")
 		}
-		resourceElements = append(resourceElements, resObj)
 
-		resourceSet, diags := types.SetValue(resourceType, resourceElements)
-		if diags.HasError() {
-			return types.SetNull(permissionType), fmt.Errorf("error creating resource set")
+		for j, res := range resources {
+			name := res.Org.ValueString()
+			hasOrgID := !res.OrgID.IsUnknown() && !res.OrgID.IsNull()
+			if name == "" && hasOrgID {
+				continue
+			}
+
+			org, err := r.organizations.findByName(ctx, name, func(ctx context.Context) (*domain.Organization, error) {
+				return r.client.OrganizationsAPI().FindOrganizationByName(ctx, name)
+			})
+			switch {
+			case err != nil && hasOrgID:
+				// Tokens may not be allowed to read organizations, the
+				// configured org_id is then used as is.
+				tflog.Debug(ctx, "Could not check permission organization name", map[string]any{"org": name, "org_id": res.OrgID.ValueString(), "error": err.Error()})
+				continue
+			case err != nil:
+				return permsSet, fmt.Errorf("could not find organization %q: %w", name, err)
+			case hasOrgID && *org.Id != res.OrgID.ValueString():
+				return permsSet, fmt.Errorf("organization %q has ID %s, not the configured org_id %s", name, *org.Id, res.OrgID.ValueString())
+			}
+
+			resources[j].OrgID = types.StringValue(*org.Id)
 		}
 
-		permObj, diags := types.ObjectValue(
-			permissionType.AttrTypes,
-			map[string]attr.Value{
-				"action":   types.StringValue(string(perm.Action)),
-				"resource": resourceSet,
-			},
-		)
+		resourceSet, diags := types.SetValueFrom(ctx, perm.Resource.ElementType(ctx), resources)
 		if diags.HasError() {
-			return types.SetNull(permissionType), fmt.Errorf("error creating permission object")
+			return permsSet, fmt.Errorf("error converting resources set")
 		}
-		elements = append(elements, permObj)
+		permissions[i].Resource = resourceSet
 	}
 
-	setValue, diags := types.SetValue(permissionType, elements)
+	resolved, diags := types.SetValueFrom(ctx, permsSet.ElementType(ctx), permissions)
 	if diags.HasError() {
-		return types.SetNull(permissionType), fmt.Errorf("error creating permissions set")
+		return permsSet, fmt.Errorf("error converting permissions set")
+	}
+
+	return resolved, nil
+}
+
+// Helper function to parse and validate a JSON-encoded permission array
+func parsePermissionsJSON(value string) ([]domain.Permission, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(value))
+	decoder.DisallowUnknownFields()
+
+	var permissions []domain.Permission
+	if err := decoder.Decode(&permissions); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the permission array")
+	}
+
+	if len(permissions) == 0 {
+		return nil, fmt.Errorf("at least one permission is required")
+	}
+
+	for i, perm := range permissions {
+		switch perm.Action {
+		case domain.PermissionActionRead, domain.PermissionActionWrite:
+		default:
+			return nil, fmt.Errorf("permission %d: action must be 'read' or 'write', got: %q", i, perm.Action)
+		}
+		if perm.Resource.Type == "" {
+			return nil, fmt.Errorf("permission %d: resource type is required", i)
+		}
+	}
+
+	return permissions, nil
+}
+
+// Helper function to format an optional API timestamp as RFC3339
+func timestampValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringValue("")
 	}
 
-	return setValue, nil
+	return types.StringValue(t.Format(time.RFC3339))
 }
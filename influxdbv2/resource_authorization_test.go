@@ -1,11 +1,20 @@
 package influxdbv2
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
 func TestAccAuthorizationResource(t *testing.T) {
@@ -26,6 +35,8 @@ func TestAccAuthorizationResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "id"),
 					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "token"),
 					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "user_id"),
+					resource.TestMatchResourceAttr("influxdb-v2_authorization.test", "created_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestMatchResourceAttr("influxdb-v2_authorization.test", "updated_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
 				),
 			},
 			// ImportState testing
@@ -68,6 +79,7 @@ func TestAccAuthorizationResource_ReadPermission(t *testing.T) {
 					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "org_id", orgID),
 					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "id"),
 					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "token"),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "permission_count", "1"),
 				),
 			},
 		},
@@ -108,12 +120,520 @@ func TestAccAuthorizationResource_MultiplePermissions(t *testing.T) {
 					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "org_id", orgID),
 					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "id"),
 					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "token"),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "permission_count", "3"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccAuthorizationResource_MultiResourcePermission(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+	config := testAccAuthorizationResourceConfigMultiResourcePermission(orgID, bucketID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "permissions.#", "1"),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "permissions.0.resource.#", "2"),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "permission_count", "2"),
+				),
+			},
+			// The imported block keeps both resources
+			{
+				ResourceName: "influxdb-v2_authorization.test",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attributes := states[0].Attributes
+					if attributes["permissions.#"] != "1" || attributes["permissions.0.resource.#"] != "2" {
+						return fmt.Errorf("expected a single permission with 2 resources, got %s permissions", attributes["permissions.#"])
+					}
+					return nil
+				},
+			},
+			// Refreshing must not plan any change
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_ImportOrgName(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationResourceConfigReadOnly(orgID, bucketID),
+			},
+			{
+				ResourceName: "influxdb-v2_authorization.test",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported authorization, got %d", len(states))
+					}
+					for key, value := range states[0].Attributes {
+						if regexp.MustCompile(`^permissions\.\d+\.resource\.\d+\.org$`).MatchString(key) && value == "" {
+							return fmt.Errorf("expected %s to be resolved from the organization ID", key)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_OrgNamePermission(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+	config := testAccAuthorizationResourceConfigOrgName(orgID, bucketID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "permissions.0.resource.0.org"),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "permissions.0.resource.0.org_id", orgID),
+				),
+			},
+			// The resolved org_id must not plan any change
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_PermissionOrgConflict(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id = %[1]q
+
+  permissions {
+    action = "read"
+    resource {
+      id     = %[2]q
+      org    = "some-org"
+      org_id = %[1]q
+      type   = "buckets"
+    }
+  }
+}
+`, orgID, bucketID),
+				ExpectError: regexp.MustCompile(`Invalid Permission Organization`),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_RotationTrigger(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationResourceConfigRotation(orgID, bucketID, "2024-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "rotation_trigger", "2024-01"),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "deletion_policy", "deactivate"),
+				),
+			},
+			{
+				Config: testAccAuthorizationResourceConfigRotation(orgID, bucketID, "2024-02"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdb-v2_authorization.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "rotation_trigger", "2024-02"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_PermissionsChange(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationResourceConfigReadOnly(orgID, bucketID),
+			},
+			// Permissions cannot be updated in place
+			{
+				Config: testAccAuthorizationResourceConfigWriteOnly(orgID, bucketID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdb-v2_authorization.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("influxdb-v2_authorization.test", "permissions.*.action", "write"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_InvalidResourceID(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAuthorizationResourceConfigReadOnly(orgID, "telegraf"),
+				ExpectError: regexp.MustCompile(`Invalid Permission Resource ID`),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_InvalidDeletionPolicy(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id          = %[1]q
+  deletion_policy = "archive"
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Invalid Deletion Policy`),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_NamedResource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationResourceConfigNamedResource(orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("influxdb-v2_authorization.test", "permissions.*.resource.*", map[string]string{
+						"name": "test-authorization-named-bucket",
+						"type": "buckets",
+					}),
+				),
+			},
+			// Refreshing keeps the name
+			{
+				Config:   testAccAuthorizationResourceConfigNamedResource(orgID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_PermissionsJSON(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationResourceConfigPermissionsJSON(orgID, bucketID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "org_id", orgID),
+					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "id"),
+					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "token"),
+					resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "permissions_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_PermissionsJSONInvalid(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id           = %[1]q
+  permissions_json = jsonencode([{ action = "delete", resource = { type = "buckets", orgID = %[1]q } }])
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Invalid Permissions JSON`),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_PermissionsJSONConflict(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id           = %[1]q
+  permissions_json = jsonencode([{ action = "read", resource = { type = "buckets", id = %[2]q, orgID = %[1]q } }])
+
+  permissions {
+    action = "read"
+    resource {
+      id     = %[2]q
+      org_id = %[1]q
+      type   = "buckets"
+    }
+  }
+}
+`, orgID, bucketID),
+				ExpectError: regexp.MustCompile(`Conflicting Permissions Configuration`),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_Preset(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	for preset, count := range map[string]string{
+		authorizationPresetAllAccess: "44",
+		authorizationPresetOperator:  "46",
+	} {
+		t.Run(preset, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccAuthorizationResourceConfigPreset(orgID, preset),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "preset", preset),
+							resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "permission_count", count),
+							resource.TestCheckResourceAttrSet("influxdb-v2_authorization.test", "token"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccAuthorizationResource_PresetConflict(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAuthorizationResourceConfigPreset(orgID, "admin"),
+				ExpectError: regexp.MustCompile(`Invalid Preset`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id = %[1]q
+  preset = "all_access"
+
+  permissions {
+    action = "read"
+    resource {
+      id     = %[2]q
+      org_id = %[1]q
+      type   = "buckets"
+    }
+  }
+}
+`, orgID, bucketID),
+				ExpectError: regexp.MustCompile(`Conflicting Permissions Configuration`),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_CrossOrg(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	otherOrgID := os.Getenv("INFLUXDB_V2_SECOND_ORG_ID")
+	if otherOrgID == "" {
+		t.Skip("INFLUXDB_V2_SECOND_ORG_ID must be set to test cross-organization tokens")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationResourceConfigCrossOrg(orgID, otherOrgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "org_id", orgID),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "owner_org_id", orgID),
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "user_org_id", orgID),
+					resource.TestCheckTypeSetElemNestedAttrs("influxdb-v2_authorization.test", "permissions.*.resource.*", map[string]string{
+						"id":     otherOrgID,
+						"org_id": otherOrgID,
+						"type":   "orgs",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_CreateInactive(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The token is created disabled
+			{
+				Config: testAccAuthorizationResourceConfig(orgID, bucketID, "inactive", "Staged authorization"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "status", "inactive"),
+					testAccCaptureResourceID("influxdb-v2_authorization.test", &id),
+					testAccCheckAuthorizationStatus(t, &id, "inactive"),
+				),
+			},
+			// Activating it later updates it in place
+			{
+				Config: testAccAuthorizationResourceConfig(orgID, bucketID, "active", "Staged authorization"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdb-v2_authorization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "status", "active"),
+					testAccCheckAuthorizationStatus(t, &id, "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuthorizationResource_StatusDrift(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+	config := testAccAuthorizationResourceConfig(orgID, bucketID, "active", "Status drift")
+
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "status", "active"),
+					testAccCaptureResourceID("influxdb-v2_authorization.test", &id),
+				),
+			},
+			// Deactivating the token out of band must plan an update reverting it
+			{
+				PreConfig: func() { testAccDeactivateAuthorization(t, id) },
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdb-v2_authorization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_authorization.test", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+// Helper function to record the ID of a resource for later steps
+func testAccCaptureResourceID(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// Helper function to deactivate an authorization outside of Terraform
+func testAccDeactivateAuthorization(t *testing.T, id string) {
+	client := testAccClient(t)
+
+	authorization := domain.Authorization{Id: &id}
+	_, err := client.AuthorizationsAPI().UpdateAuthorizationStatus(context.Background(), &authorization, domain.AuthorizationUpdateRequestStatusInactive)
+	if err != nil {
+		t.Fatalf("could not deactivate authorization %s: %s", id, err)
+	}
+}
+
+// Helper function to check the status of an authorization on the server
+func testAccCheckAuthorizationStatus(t *testing.T, id *string, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var authorization domain.Authorization
+		if err := doAPIRequest(context.Background(), testAccClient(t), http.MethodGet, "authorizations/"+*id, nil, &authorization); err != nil {
+			return err
+		}
+		if authorization.Status == nil || string(*authorization.Status) != status {
+			return fmt.Errorf("expected authorization %s to be %s, got %v", *id, status, authorization.Status)
+		}
+
+		return nil
+	}
+}
+
 func testAccAuthorizationResourceConfig(orgID, bucketID, status, description string) string {
 	return fmt.Sprintf(`
 resource "influxdb-v2_authorization" "test" {
@@ -161,6 +681,30 @@ resource "influxdb-v2_authorization" "test" {
 `, orgID, bucketID)
 }
 
+func testAccAuthorizationResourceConfigOrgName(orgID, bucketID string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_organizations" "test" {}
+
+locals {
+  org_name = one([for org in data.influxdb-v2_organizations.test.organizations : org.name if org.id == %[1]q])
+}
+
+resource "influxdb-v2_authorization" "test" {
+  org_id      = %[1]q
+  description = "Authorization with an organization name"
+
+  permissions {
+    action = "read"
+    resource {
+      id   = %[2]q
+      org  = local.org_name
+      type = "buckets"
+    }
+  }
+}
+`, orgID, bucketID)
+}
+
 func testAccAuthorizationResourceConfigWriteOnly(orgID, bucketID string) string {
 	return fmt.Sprintf(`
 resource "influxdb-v2_authorization" "test" {
@@ -216,3 +760,338 @@ resource "influxdb-v2_authorization" "test" {
 }
 `, orgID, bucketID)
 }
+
+func testAccAuthorizationResourceConfigMultiResourcePermission(orgID, bucketID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id      = %[1]q
+  status      = "active"
+  description = "Authorization with a multi-resource permission"
+
+  permissions {
+    action = "read"
+    resource {
+      id     = %[2]q
+      org_id = %[1]q
+      type   = "buckets"
+    }
+    resource {
+      id     = %[1]q
+      org_id = %[1]q
+      type   = "orgs"
+    }
+  }
+}
+`, orgID, bucketID)
+}
+
+func testAccAuthorizationResourceConfigPermissionsJSON(orgID, bucketID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id      = %[1]q
+  status      = "active"
+  description = "Authorization with JSON permissions"
+
+  permissions_json = jsonencode([
+    { action = "read", resource = { type = "buckets", id = %[2]q, orgID = %[1]q } },
+    { action = "write", resource = { type = "buckets", id = %[2]q, orgID = %[1]q } },
+  ])
+}
+`, orgID, bucketID)
+}
+
+func testAccAuthorizationResourceConfigPreset(orgID, preset string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id      = %[1]q
+  description = "Preset token"
+  preset      = %[2]q
+}
+`, orgID, preset)
+}
+
+func testAccAuthorizationResourceConfigRotation(orgID, bucketID, trigger string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id           = %[1]q
+  description      = "Rotated authorization"
+  rotation_trigger = %[3]q
+  deletion_policy  = "deactivate"
+
+  permissions {
+    action = "read"
+    resource {
+      id     = %[2]q
+      org_id = %[1]q
+      type   = "buckets"
+    }
+  }
+}
+`, orgID, bucketID, trigger)
+}
+
+func testAccAuthorizationResourceConfigNamedResource(orgID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name   = "test-authorization-named-bucket"
+  org_id = %[1]q
+}
+
+resource "influxdb-v2_authorization" "test" {
+  org_id      = %[1]q
+  description = "Authorization on a named resource"
+
+  permissions {
+    action = "read"
+    resource {
+      id     = influxdb-v2_bucket.test.id
+      name   = influxdb-v2_bucket.test.name
+      org_id = %[1]q
+      type   = "buckets"
+    }
+  }
+}
+`, orgID)
+}
+
+func testAccAuthorizationResourceConfigCrossOrg(orgID, otherOrgID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_authorization" "test" {
+  org_id      = %[1]q
+  description = "Cross-organization token"
+
+  permissions {
+    action = "read"
+    resource {
+      id     = %[2]q
+      org_id = %[2]q
+      type   = "orgs"
+    }
+  }
+}
+`, orgID, otherOrgID)
+}
+
+func TestValidatePermissionOrgs(t *testing.T) {
+	cases := []struct {
+		name    string
+		org     types.String
+		orgID   types.String
+		wantErr bool
+	}{
+		{name: "org_id", org: types.StringNull(), orgID: types.StringValue("94d518926178fea7")},
+		{name: "org name", org: types.StringValue("company"), orgID: types.StringNull()},
+		{name: "unknown org_id", org: types.StringNull(), orgID: types.StringUnknown()},
+		{name: "both", org: types.StringValue("company"), orgID: types.StringValue("94d518926178fea7")},
+		{name: "neither", org: types.StringNull(), orgID: types.StringNull(), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			permissions := testPermissionsSet(t, ResourceModel{
+				ID:    types.StringValue("0b5e7f9c3a2d4680"),
+				Name:  types.StringNull(),
+				Org:   tc.org,
+				OrgID: tc.orgID,
+				Type:  types.StringValue("buckets"),
+			})
+
+			diags := validatePermissionOrgs(context.Background(), permissions)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestValidatePermissionIDs(t *testing.T) {
+	cases := []struct {
+		name    string
+		id      types.String
+		wantErr bool
+	}{
+		{name: "valid", id: types.StringValue("0b5e7f9c3a2d4680")},
+		{name: "upper case", id: types.StringValue("0B5E7F9C3A2D4680")},
+		{name: "every resource of the type", id: types.StringValue("")},
+		{name: "unknown", id: types.StringUnknown()},
+		{name: "bucket name", id: types.StringValue("telegraf"), wantErr: true},
+		{name: "too short", id: types.StringValue("0b5e7f9c3a2d468"), wantErr: true},
+		{name: "not hexadecimal", id: types.StringValue("0b5e7f9c3a2d468z"), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			permissions := testPermissionsSet(t, ResourceModel{
+				ID:    tc.id,
+				Name:  types.StringNull(),
+				Org:   types.StringNull(),
+				OrgID: types.StringValue("94d518926178fea7"),
+				Type:  types.StringValue("buckets"),
+			})
+
+			diags := validatePermissionIDs(context.Background(), permissions)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestResolvePermissionOrgIDs(t *testing.T) {
+	cache := newOrganizationsCache()
+	orgID := "94d518926178fea7"
+	_, _ = cache.findByName(context.Background(), "company", func(ctx context.Context) (*domain.Organization, error) {
+		return &domain.Organization{Id: &orgID, Name: "company"}, nil
+	})
+
+	r := &AuthorizationResource{organizations: cache}
+	permissions := testPermissionsSet(t,
+		ResourceModel{
+			ID:    types.StringValue("0b5e7f9c3a2d4680"),
+			Name:  types.StringValue(""),
+			Org:   types.StringValue("company"),
+			OrgID: types.StringUnknown(),
+			Type:  types.StringValue("buckets"),
+		},
+		ResourceModel{
+			ID:    types.StringValue("1c6f8a0d4b3e5791"),
+			Name:  types.StringValue(""),
+			Org:   types.StringValue(""),
+			OrgID: types.StringValue("05e2ad7c1b3f4968"),
+			Type:  types.StringValue("buckets"),
+		},
+	)
+
+	resolved, err := r.resolvePermissionOrgIDs(context.Background(), permissions)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	domainPermissions, err := convertPermissionsToDomain(context.Background(), resolved)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	orgIDs := map[string]string{}
+	for _, perm := range domainPermissions {
+		orgIDs[*perm.Resource.Id] = *perm.Resource.OrgID
+	}
+	if orgIDs["0b5e7f9c3a2d4680"] != orgID || orgIDs["1c6f8a0d4b3e5791"] != "05e2ad7c1b3f4968" {
+		t.Errorf("unexpected organization IDs: %v", orgIDs)
+	}
+}
+
+func TestResolvePermissionOrgIDs_BothSet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"code":"forbidden","message":"insufficient permissions for read:orgs"}`)
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	cache := newOrganizationsCache()
+	orgID := "94d518926178fea7"
+	_, _ = cache.findByName(context.Background(), "company", func(ctx context.Context) (*domain.Organization, error) {
+		return &domain.Organization{Id: &orgID, Name: "company"}, nil
+	})
+
+	for _, tc := range []struct {
+		name    string
+		org     string
+		orgID   string
+		wantErr bool
+	}{
+		{name: "same organization", org: "company", orgID: orgID},
+		{name: "other organization", org: "company", orgID: "05e2ad7c1b3f4968", wantErr: true},
+		{name: "unreadable organization", org: "subsidiary", orgID: "05e2ad7c1b3f4968"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &AuthorizationResource{client: client, organizations: cache}
+			permissions := testPermissionsSet(t, ResourceModel{
+				ID:    types.StringValue("0b5e7f9c3a2d4680"),
+				Name:  types.StringValue(""),
+				Org:   types.StringValue(tc.org),
+				OrgID: types.StringValue(tc.orgID),
+				Type:  types.StringValue("buckets"),
+			})
+
+			resolved, err := r.resolvePermissionOrgIDs(context.Background(), permissions)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %t, got: %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			domainPermissions, err := convertPermissionsToDomain(context.Background(), resolved)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := *domainPermissions[0].Resource.OrgID; got != tc.orgID {
+				t.Errorf("expected org_id %s, got %s", tc.orgID, got)
+			}
+		})
+	}
+}
+
+func TestResolvePermissionOrgs(t *testing.T) {
+	cache := newOrganizationsCache()
+	orgID := "94d518926178fea7"
+	_, _ = cache.findByName(context.Background(), "company", func(ctx context.Context) (*domain.Organization, error) {
+		return &domain.Organization{Id: &orgID, Name: "company"}, nil
+	})
+
+	for _, tc := range []struct {
+		name            string
+		resolveOrgNames bool
+		want            string
+	}{
+		{name: "enabled", resolveOrgNames: true, want: "company"},
+		{name: "disabled", resolveOrgNames: false, want: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &AuthorizationResource{organizations: cache, resolveOrgNames: tc.resolveOrgNames}
+			bucketID := "0b5e7f9c3a2d4680"
+			permissions := []domain.Permission{{
+				Action:   domain.PermissionActionRead,
+				Resource: domain.Resource{Type: domain.ResourceTypeBuckets, Id: &bucketID, OrgID: &orgID},
+			}}
+
+			r.resolvePermissionOrgs(context.Background(), permissions)
+
+			converted, err := convertPermissionsToTerraform(context.Background(), permissions)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var models []PermissionModel
+			if diags := converted.ElementsAs(context.Background(), &models, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			var resources []ResourceModel
+			if diags := models[0].Resource.ElementsAs(context.Background(), &resources, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got := resources[0].Org.ValueString(); got != tc.want {
+				t.Errorf("expected org %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+// Helper function to build a read permissions set from resource models
+func testPermissionsSet(t *testing.T, resources ...ResourceModel) types.Set {
+	resourceSet, diags := types.SetValueFrom(context.Background(), permissionResourceObjectType, resources)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	permissions, diags := types.SetValueFrom(context.Background(), permissionObjectType, []PermissionModel{
+		{Action: types.StringValue("read"), Resource: resourceSet},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return permissions
+}
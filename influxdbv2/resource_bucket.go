@@ -3,24 +3,47 @@ package influxdbv2
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
+const (
+	// bucketReadAfterCreateAttempts bounds the reads of a bucket just created.
+	bucketReadAfterCreateAttempts = 3
+	// bucketReadAfterCreateBackoff is the delay before reading a bucket just
+	// created again, doubled on each attempt.
+	bucketReadAfterCreateBackoff = 500 * time.Millisecond
+	// bucketDeletePollInterval is the delay between two checks that a deleted
+	// bucket is gone.
+	bucketDeletePollInterval = time.Second
+)
+
+// annotationLabelPrefix is the prefix of the labels storing bucket annotations.
+const annotationLabelPrefix = "tf:"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BucketResource{}
 var _ resource.ResourceWithImportState = &BucketResource{}
+var _ resource.ResourceWithValidateConfig = &BucketResource{}
 
 func NewBucketResource() resource.Resource {
 	return &BucketResource{}
@@ -28,20 +51,66 @@ func NewBucketResource() resource.Resource {
 
 // BucketResource defines the resource implementation.
 type BucketResource struct {
-	client influxdb2.Client
+	client      influxdb2.Client
+	validateOrg bool
+	edition     string
+
+	defaultSchemaType string
+	organizations     *organizationsCache
 }
 
 // BucketResourceModel describes the resource data model.
 type BucketResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	OrgID          types.String `tfsdk:"org_id"`
-	RetentionRules types.Set    `tfsdk:"retention_rules"`
-	RP             types.String `tfsdk:"rp"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
-	Type           types.String `tfsdk:"type"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	OrgID              types.String   `tfsdk:"org_id"`
+	RetentionRules     types.Set      `tfsdk:"retention_rules"`
+	RetentionSeconds   types.Int64    `tfsdk:"retention_seconds"`
+	InfiniteRetention  types.Bool     `tfsdk:"infinite_retention"`
+	ShardGroupDuration types.Int64    `tfsdk:"shard_group_duration_seconds"`
+	RP                 types.String   `tfsdk:"rp"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	Type               types.String   `tfsdk:"type"`
+	SchemaType         types.String   `tfsdk:"schema_type"`
+	CloneFromBucketID  types.String   `tfsdk:"clone_from_bucket_id"`
+	Labels             types.List     `tfsdk:"labels"`
+	DBRPIDs            types.List     `tfsdk:"dbrp_ids"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	CheckNameCollision types.Bool     `tfsdk:"check_name_collision"`
+	WaitForDelete      types.Bool     `tfsdk:"wait_for_delete"`
+	MeasurementSchemas types.List     `tfsdk:"measurement_schemas"`
+	Annotations        types.Map      `tfsdk:"annotations"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// MeasurementSchemaModel describes the measurement schema data model.
+type MeasurementSchemaModel struct {
+	Name    types.String                   `tfsdk:"name"`
+	Columns []MeasurementSchemaColumnModel `tfsdk:"columns"`
+}
+
+// MeasurementSchemaColumnModel describes a column of a measurement schema.
+type MeasurementSchemaColumnModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	DataType types.String `tfsdk:"data_type"`
+}
+
+// measurementSchema is a measurement schema of the InfluxDB API, which the
+// client does not cover.
+type measurementSchema struct {
+	ID      string                    `json:"id,omitempty"`
+	Name    string                    `json:"name,omitempty"`
+	Columns []measurementSchemaColumn `json:"columns"`
+}
+
+// measurementSchemaColumn is a column of a measurement schema of the InfluxDB API.
+type measurementSchemaColumn struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	DataType string `json:"dataType,omitempty"`
 }
 
 // RetentionRuleModel describes the retention rule data model.
@@ -76,38 +145,161 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:     stringdefault.StaticString(""),
 			},
 			"org_id": schema.StringAttribute{
-				Description: "The organization ID.",
-				Required:    true,
+				Description: "The organization ID. Buckets cannot be moved between organizations, changing it " +
+					"destroys the bucket with all its data and creates an empty one.",
+				Required: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						bucketOrgIDRequiresReplace,
+						"Changing the organization destroys the bucket and its data.",
+						"Changing the organization destroys the bucket and its data.",
+					),
+				},
+			},
+			"retention_seconds": schema.Int64Attribute{
+				Description: "Shorthand for a single 'expire' retention rule of the given duration in seconds. Conflicts with retention_rules.",
+				Optional:    true,
+			},
+			"infinite_retention": schema.BoolAttribute{
+				Description: "Keep data forever. Conflicts with retention_seconds and retention rules expiring data. " +
+					"Computed from the retention rules when not set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					infiniteRetentionModifier{},
 				},
 			},
+			"shard_group_duration_seconds": schema.Int64Attribute{
+				Description: "Duration in seconds covered by each shard group, at most the retention duration. " +
+					"Updated in place. Left to the server default when not set. Not used by InfluxDB Cloud.",
+				Optional: true,
+			},
 			"rp": schema.StringAttribute{
-				Description: "The retention policy name.",
+				Description: "The retention policy name. When not set, the value assigned by the server is kept.",
 				Optional:    true,
 				Computed:    true,
-				Default:     stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_at": schema.StringAttribute{
-				Description: "The timestamp when the bucket was created.",
+				Description: "The RFC3339 timestamp when the bucket was created.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"updated_at": schema.StringAttribute{
-				Description: "The timestamp when the bucket was last updated.",
+				Description: "The RFC3339 timestamp when the bucket was last updated.",
 				Computed:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "The type of the bucket.",
+				Description: "The type of the bucket. This is assigned by the server and cannot be configured.",
+				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"schema_type": schema.StringAttribute{
+				Description: "The schema type of the bucket, 'implicit' or 'explicit'. Defaults to the provider " +
+					"default_schema_type, or to the server default when it is not set. Changing it destroys the " +
+					"bucket with all its data and creates an empty one.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(
+						bucketSchemaTypeRequiresReplace,
+						"Changing the schema type destroys the bucket and its data.",
+						"Changing the schema type destroys the bucket and its data.",
+					),
+				},
+			},
+			"clone_from_bucket_id": schema.StringAttribute{
+				Description: "ID of an existing bucket whose retention rules and schema type are copied on create " +
+					"when they are not set. Changing it after creation has no effect.",
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Refuse to delete the bucket while true. It must be set to false and applied before the bucket can be destroyed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"check_name_collision": schema.BoolAttribute{
+				Description: "Check that no other bucket of the organization has the name before creating or renaming " +
+					"the bucket, to report a clear error instead of the API conflict. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"wait_for_delete": schema.BoolAttribute{
+				Description: "Wait until the bucket can no longer be found after deleting it, up to the delete timeout. " +
+					"InfluxDB Cloud deletes buckets asynchronously, so recreating a bucket with the same name right away " +
+					"may otherwise conflict with the old one. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"annotations": schema.MapAttribute{
+				Description: "Key/value metadata of the bucket, stored as labels named '" + annotationLabelPrefix + "<key>=<value>' " +
+					"attached to the bucket. The labels are created in the organization of the bucket when missing.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels": schema.ListAttribute{
+				Description: "IDs of the labels attached to the bucket, except the annotation labels. Read-only, manage attachments with dedicated resources.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dbrp_ids": schema.ListAttribute{
+				Description: "IDs of the DBRP mappings pointing at the bucket. Read-only, manage mappings with dedicated resources.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+			"measurement_schemas": schema.ListNestedBlock{
+				Description: "Measurement schemas created with the bucket, only for buckets with an explicit schema type. " +
+					"Schemas and columns can be added but not removed.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the measurement.",
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"columns": schema.ListNestedBlock{
+							Description: "The columns of the measurement, including exactly one 'timestamp' column named 'time'.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the column.",
+										Required:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "The type of the column, 'timestamp', 'tag' or 'field'.",
+										Required:    true,
+									},
+									"data_type": schema.StringAttribute{
+										Description: "The data type of a field column, 'integer', 'float', 'boolean', 'string' or 'unsigned'.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"retention_rules": schema.SetNestedBlock{
 				Description: "Retention rules for the bucket.",
 				NestedObject: schema.NestedBlockObject{
@@ -115,9 +307,6 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						"every_seconds": schema.Int64Attribute{
 							Description: "Duration in seconds for how long data will be kept in the database.",
 							Required:    true,
-							PlanModifiers: []planmodifier.Int64{
-								int64planmodifier.UseStateForUnknown(),
-							},
 						},
 						"type": schema.StringAttribute{
 							Description: "Type of retention rule. Defaults to 'expire'.",
@@ -138,17 +327,129 @@ func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(influxdb2.Client)
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.validateOrg = providerData.validateOrg
+	r.edition = providerData.edition
+	r.defaultSchemaType = providerData.defaultSchemaType
+	r.organizations = providerData.organizations
+}
+
+func (r *BucketResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BucketResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAnnotations(ctx, config.Annotations)...)
+
+	if !config.RetentionSeconds.IsNull() && len(config.RetentionRules.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retention_seconds"),
+			"Conflicting Retention Configuration",
+			"Only one of retention_seconds or retention_rules blocks may be set.",
+		)
+	}
+
+	if config.InfiniteRetention.ValueBool() {
+		expiring := config.RetentionSeconds.ValueInt64() > 0
+
+		var rules []RetentionRuleModel
+		resp.Diagnostics.Append(config.RetentionRules.ElementsAs(ctx, &rules, false)...)
+		for _, rule := range rules {
+			if rule.EverySeconds.ValueInt64() > 0 {
+				expiring = true
+			}
+		}
+
+		if expiring {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("infinite_retention"),
+				"Conflicting Retention Configuration",
+				"infinite_retention cannot be set together with retention_seconds or retention rules expiring data.",
+			)
+		}
+	}
+
+	if !config.ShardGroupDuration.IsNull() && !config.ShardGroupDuration.IsUnknown() {
+		shardGroupDuration := config.ShardGroupDuration.ValueInt64()
+		retention, known := r.plannedRetentionSeconds(ctx, &config)
+
+		switch {
+		case shardGroupDuration <= 0:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("shard_group_duration_seconds"),
+				"Invalid Shard Group Duration",
+				"The shard_group_duration_seconds must be a positive number of seconds.",
+			)
+		case known && retention > 0 && shardGroupDuration > retention:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("shard_group_duration_seconds"),
+				"Invalid Shard Group Duration",
+				fmt.Sprintf("InfluxDB rejects a shard group duration longer than the retention: shard_group_duration_seconds is %d "+
+					"but the bucket keeps data for %d seconds. Lower the shard group duration or raise the retention.",
+					shardGroupDuration, retention),
+			)
+		}
+	}
+
+	if len(config.MeasurementSchemas.Elements()) > 0 {
+		// An unset schema type may come from the provider default_schema_type,
+		// which is only known when creating the bucket.
+		if !config.SchemaType.IsNull() && !config.SchemaType.IsUnknown() && config.SchemaType.ValueString() != string(domain.SchemaTypeExplicit) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("measurement_schemas"),
+				"Invalid Measurement Schemas",
+				"Measurement schemas can only be set on buckets with schema_type set to 'explicit'.",
+			)
+		}
+
+		var schemas []MeasurementSchemaModel
+		resp.Diagnostics.Append(config.MeasurementSchemas.ElementsAs(ctx, &schemas, false)...)
+		for i, measurement := range schemas {
+			if err := validateMeasurementSchemaColumns(measurement.Columns); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("measurement_schemas").AtListIndex(i),
+					"Invalid Measurement Schema",
+					"Invalid columns for measurement "+measurement.Name.ValueString()+": "+err.Error(),
+				)
+			}
+		}
+	}
+
+	if !config.SchemaType.IsNull() && !config.SchemaType.IsUnknown() {
+		switch domain.SchemaType(config.SchemaType.ValueString()) {
+		case domain.SchemaTypeImplicit, domain.SchemaTypeExplicit:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schema_type"),
+				"Invalid Bucket Schema Type",
+				"The schema_type must be either 'implicit' or 'explicit', got: "+config.SchemaType.ValueString(),
+			)
+		}
+	}
+
+	// The type is only optional so that a clear error can be returned when
+	// it is copied over from imported state.
+	if !config.Type.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Bucket Type Is Server-Assigned",
+			"The bucket type is assigned by the InfluxDB server and cannot be set in configuration. "+
+				"Remove the type attribute from the bucket resource.",
+		)
+	}
 }
 
 func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -160,8 +461,17 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Convert retention rules from Terraform data to domain model
-	retentionRules, err := r.convertRetentionRulesToDomain(ctx, plan.RetentionRules)
+	retentionRules, err := r.retentionRulesFromModel(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Converting Retention Rules",
@@ -170,17 +480,97 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	if r.validateOrg {
+		orgID := plan.OrgID.ValueString()
+		_, err := r.organizations.findByID(ctx, orgID, func(ctx context.Context) (*domain.Organization, error) {
+			return r.client.OrganizationsAPI().FindOrganizationByID(ctx, orgID)
+		})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("org_id"),
+				"Organization Not Found",
+				"Could not find organization ID "+plan.OrgID.ValueString()+", check it is not mistyped: "+formatAPIError(err),
+			)
+			return
+		}
+	}
+
+	if plan.CheckNameCollision.ValueBool() {
+		resp.Diagnostics.Append(r.checkNameCollision(ctx, plan.OrgID.ValueString(), plan.Name.ValueString(), "")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	schemas, err := measurementSchemasFromModel(ctx, plan.MeasurementSchemas)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Converting Measurement Schemas",
+			"Could not convert measurement schemas: "+err.Error(),
+		)
+		return
+	}
+	if len(schemas) > 0 {
+		resp.Diagnostics.Append(requireEdition(r.edition, editionCloud, "Measurement schemas")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Create bucket
 	desc := plan.Description.ValueString()
 	orgID := plan.OrgID.ValueString()
-	rp := plan.RP.ValueString()
 
 	newBucket := &domain.Bucket{
 		Description:    &desc,
 		Name:           plan.Name.ValueString(),
 		OrgID:          &orgID,
 		RetentionRules: retentionRules,
-		Rp:             &rp,
+	}
+
+	// An unset retention policy name is left to the server.
+	if !plan.RP.IsUnknown() && !plan.RP.IsNull() {
+		rp := plan.RP.ValueString()
+		newBucket.Rp = &rp
+	}
+
+	if !plan.SchemaType.IsUnknown() && !plan.SchemaType.IsNull() {
+		schemaType := domain.SchemaType(plan.SchemaType.ValueString())
+		newBucket.SchemaType = &schemaType
+	}
+
+	// Copy unset retention rules and schema type from the clone source
+	if !plan.CloneFromBucketID.IsNull() {
+		source, err := r.client.BucketsAPI().FindBucketByID(ctx, plan.CloneFromBucketID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("clone_from_bucket_id"),
+				"Error Reading Source Bucket",
+				"Could not find bucket ID "+plan.CloneFromBucketID.ValueString()+" to clone from: "+formatAPIError(err),
+			)
+			return
+		}
+
+		if plan.retentionFromClone() {
+			newBucket.RetentionRules = source.RetentionRules
+		}
+		if newBucket.SchemaType == nil {
+			newBucket.SchemaType = source.SchemaType
+		}
+	}
+
+	if newBucket.SchemaType == nil && r.defaultSchemaType != "" {
+		schemaType := domain.SchemaType(r.defaultSchemaType)
+		newBucket.SchemaType = &schemaType
+	}
+
+	if len(schemas) > 0 && (newBucket.SchemaType == nil || *newBucket.SchemaType != domain.SchemaTypeExplicit) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("measurement_schemas"),
+			"Invalid Measurement Schemas",
+			"Measurement schemas can only be set on buckets with schema_type set to 'explicit'.",
+		)
+		return
 	}
 
 	tflog.Debug(ctx, "Creating bucket", map[string]any{"name": plan.Name.ValueString()})
@@ -189,7 +579,7 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Bucket",
-			"Could not create bucket, unexpected error: "+err.Error(),
+			"Could not create bucket: "+formatAPIError(err),
 		)
 		return
 	}
@@ -197,12 +587,50 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Set the ID and read the resource to populate computed fields
 	plan.ID = types.StringValue(*result.Id)
 
-	// Read the created bucket to get all computed fields
-	if err := r.readBucket(ctx, &plan); err != nil {
+	// The schemas can only be created once the bucket exists, a failure
+	// leaves the bucket in state to be completed on the next apply.
+	if err := r.writeMeasurementSchemas(ctx, *result.Id, schemas); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Measurement Schemas",
+			"Could not create measurement schemas of bucket ID "+*result.Id+": "+formatAPIError(err),
+		)
+		plan.MeasurementSchemas = types.ListNull(measurementSchemaObjectType)
+		if _, err := r.readBucket(ctx, &plan); err == nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		} else {
+			resp.Diagnostics.Append(saveCreatedBucketID(ctx, req.Plan, &resp.State, plan.ID)...)
+		}
+		return
+	}
+
+	if err := r.writeAnnotations(ctx, *result.Id, orgID, plan.Annotations); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Bucket Annotations",
+			"Could not attach the annotation labels of bucket ID "+*result.Id+": "+formatAPIError(err),
+		)
+		plan.Annotations = types.MapNull(types.StringType)
+		if _, err := r.readBucket(ctx, &plan); err == nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		} else {
+			resp.Diagnostics.Append(saveCreatedBucketID(ctx, req.Plan, &resp.State, plan.ID)...)
+		}
+		return
+	}
+
+	// Read the created bucket to get all computed fields. InfluxDB Cloud may
+	// not find a bucket right after its creation, the read is then retried.
+	var warnings diag.Diagnostics
+	err = retryNotFound(ctx, bucketReadAfterCreateAttempts, bucketReadAfterCreateBackoff, func() error {
+		warnings, err = r.readBucket(ctx, &plan)
+		return err
+	})
+	resp.Diagnostics.Append(warnings...)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Bucket After Creation",
-			"Could not read bucket after creation: "+err.Error(),
+			"Could not read bucket after creation: "+formatAPIError(err),
 		)
+		resp.Diagnostics.Append(saveCreatedBucketID(ctx, req.Plan, &resp.State, plan.ID)...)
 		return
 	}
 
@@ -221,11 +649,22 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Read the bucket from InfluxDB
-	if err := r.readBucket(ctx, &state); err != nil {
+	warnings, err := r.readBucket(ctx, &state)
+	resp.Diagnostics.Append(warnings...)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Bucket",
-			"Could not read bucket ID "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read bucket ID "+state.ID.ValueString()+": "+formatAPIError(err),
 		)
 		return
 	}
@@ -235,16 +674,80 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan BucketResourceModel
+	var plan, state BucketResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// System buckets are only adopted to tune their retention.
+	system := state.Type.ValueString() == string(domain.BucketTypeSystem)
+	if system && !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"System Bucket Cannot Be Renamed",
+			"Bucket ID "+plan.ID.ValueString()+" is the "+state.Name.ValueString()+" system bucket, which InfluxDB does not allow to rename.",
+		)
+		return
+	}
+
+	if plan.CheckNameCollision.ValueBool() && !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.Append(r.checkNameCollision(ctx, plan.OrgID.ValueString(), plan.Name.ValueString(), plan.ID.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	schemas, err := measurementSchemasFromModel(ctx, plan.MeasurementSchemas)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Converting Measurement Schemas",
+			"Could not convert measurement schemas: "+err.Error(),
+		)
+		return
+	}
+	previousSchemas, err := measurementSchemasFromModel(ctx, state.MeasurementSchemas)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Converting Measurement Schemas",
+			"Could not convert measurement schemas: "+err.Error(),
+		)
+		return
+	}
+
+	// InfluxDB cannot delete measurement schemas.
+	planned := map[string]bool{}
+	for _, measurement := range schemas {
+		planned[measurement.Name] = true
+	}
+	for _, measurement := range previousSchemas {
+		if !planned[measurement.Name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("measurement_schemas"),
+				"Measurement Schema Cannot Be Removed",
+				"InfluxDB cannot delete the schema of measurement "+measurement.Name+". Keep it in the configuration, "+
+					"or replace the bucket to start from an empty schema.",
+			)
+		}
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Convert retention rules from Terraform data to domain model
-	retentionRules, err := r.convertRetentionRulesToDomain(ctx, plan.RetentionRules)
+	retentionRules, err := r.retentionRulesFromModel(ctx, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Converting Retention Rules",
@@ -253,11 +756,17 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// An empty rules list leaves the retention untouched on update, so
+	// infinite retention is sent as an explicit zero duration rule.
+	if plan.InfiniteRetention.ValueBool() && len(retentionRules) == 0 {
+		ruleType := domain.RetentionRuleTypeExpire
+		retentionRules = domain.RetentionRules{{EverySeconds: 0, Type: &ruleType}}
+	}
+
 	// Update bucket
 	id := plan.ID.ValueString()
 	desc := plan.Description.ValueString()
 	orgID := plan.OrgID.ValueString()
-	rp := plan.RP.ValueString()
 
 	updateBucket := &domain.Bucket{
 		Id:             &id,
@@ -265,25 +774,56 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		Name:           plan.Name.ValueString(),
 		OrgID:          &orgID,
 		RetentionRules: retentionRules,
-		Rp:             &rp,
+	}
+
+	if !plan.RP.IsUnknown() && !plan.RP.IsNull() {
+		rp := plan.RP.ValueString()
+		updateBucket.Rp = &rp
 	}
 
 	tflog.Debug(ctx, "Updating bucket", map[string]any{"id": plan.ID.ValueString()})
 
-	_, err = r.client.BucketsAPI().UpdateBucket(ctx, updateBucket)
+	if system {
+		err = updateSystemBucket(ctx, r.client, updateBucket)
+	} else {
+		_, err = r.client.BucketsAPI().UpdateBucket(ctx, updateBucket)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Bucket",
-			"Could not update bucket, unexpected error: "+err.Error(),
+			"Could not update bucket: "+formatAPIError(err),
+		)
+		return
+	}
+
+	if err := r.writeMeasurementSchemas(ctx, id, schemas); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Measurement Schemas",
+			"Could not update measurement schemas of bucket ID "+id+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	// Removing the attribute removes the annotations it managed
+	annotations := plan.Annotations
+	if annotations.IsNull() && !state.Annotations.IsNull() {
+		annotations = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	if err := r.writeAnnotations(ctx, id, orgID, annotations); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Bucket Annotations",
+			"Could not update the annotation labels of bucket ID "+id+": "+formatAPIError(err),
 		)
 		return
 	}
 
 	// Read the updated bucket to get all current fields
-	if err := r.readBucket(ctx, &plan); err != nil {
+	warnings, err := r.readBucket(ctx, &plan)
+	resp.Diagnostics.Append(warnings...)
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Bucket After Update",
-			"Could not read bucket after update: "+err.Error(),
+			"Could not read bucket after update: "+formatAPIError(err),
 		)
 		return
 	}
@@ -301,6 +841,33 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Bucket Deletion Protected",
+			"Bucket ID "+state.ID.ValueString()+" has deletion_protection enabled. "+
+				"Set deletion_protection to false and apply before destroying the bucket.",
+		)
+		return
+	}
+
+	if state.Type.ValueString() == string(domain.BucketTypeSystem) {
+		resp.Diagnostics.AddError(
+			"System Bucket Cannot Be Deleted",
+			"Bucket ID "+state.ID.ValueString()+" is the "+state.Name.ValueString()+" system bucket, which InfluxDB needs. "+
+				"Stop managing it with a removed block whose lifecycle sets destroy = false, or with terraform state rm.",
+		)
+		return
+	}
+
 	tflog.Debug(ctx, "Deleting bucket", map[string]any{"id": state.ID.ValueString()})
 
 	// Delete the bucket
@@ -308,23 +875,135 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Bucket",
-			"Could not delete bucket, unexpected error: "+err.Error(),
+			"Could not delete bucket: "+formatAPIError(err),
 		)
 		return
 	}
 
+	if state.WaitForDelete.ValueBool() {
+		tflog.Debug(ctx, "Waiting for bucket deletion", map[string]any{"id": state.ID.ValueString()})
+
+		if err := waitForBucketDeletion(ctx, r.client, state.ID.ValueString(), state.OrgID.ValueString(), state.Name.ValueString(), bucketDeletePollInterval); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Waiting For Bucket Deletion",
+				"Bucket ID "+state.ID.ValueString()+" was deleted but can still be found: "+formatAPIError(err),
+			)
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "Deleted bucket", map[string]any{"id": state.ID.ValueString()})
 }
 
+// Helper function to update the description and retention rules of a system
+// bucket. The name is left out of the request, as InfluxDB rejects renaming
+// system buckets even to their current name.
+func updateSystemBucket(ctx context.Context, client influxdb2.Client, bucket *domain.Bucket) error {
+	rules := make(domain.PatchRetentionRules, len(bucket.RetentionRules))
+	for i, rule := range bucket.RetentionRules {
+		rules[i] = domain.PatchRetentionRule{
+			EverySeconds:              rule.EverySeconds,
+			ShardGroupDurationSeconds: rule.ShardGroupDurationSeconds,
+		}
+		if rule.Type != nil {
+			ruleType := domain.PatchRetentionRuleType(*rule.Type)
+			rules[i].Type = &ruleType
+		}
+	}
+
+	_, err := client.APIClient().PatchBucketsID(ctx, &domain.PatchBucketsIDAllParams{
+		BucketID: *bucket.Id,
+		Body: domain.PatchBucketsIDJSONRequestBody{
+			Description:    bucket.Description,
+			RetentionRules: &rules,
+		},
+	})
+	return err
+}
+
+// Helper function to keep a bucket whose creation could not be completed in
+// state, so that Terraform taints it instead of creating it again. The
+// planned values are saved with the unknown ones left null.
+func saveCreatedBucketID(ctx context.Context, plan tfsdk.Plan, state *tfsdk.State, id types.String) diag.Diagnostics {
+	raw, err := tftypes.Transform(plan.Raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.IsKnown() {
+			return tftypes.NewValue(value.Type(), nil), nil
+		}
+		return value, nil
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error Saving Created Bucket",
+			"Could not save bucket ID "+id.ValueString()+" to state, it must be imported: "+err.Error(),
+		)
+		return diags
+	}
+
+	state.Raw = raw
+	return state.SetAttribute(ctx, path.Root("id"), id)
+}
+
+// Helper function to poll a deleted bucket until it can no longer be found,
+// by ID nor by name, or the context expires. InfluxDB Cloud deletes buckets
+// asynchronously and may keep the name in use after the ID is gone.
+func waitForBucketDeletion(ctx context.Context, client influxdb2.Client, bucketID, orgID, name string, interval time.Duration) error {
+	for {
+		err := findDeletedBucket(ctx, client, bucketID, orgID, name)
+		if err == nil {
+			return nil
+		}
+
+		tflog.Debug(ctx, "Deleted bucket still found", map[string]any{"id": bucketID, "error": err.Error()})
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Helper function to look up a deleted bucket by ID and by name. It returns
+// nil once neither lookup finds it.
+func findDeletedBucket(ctx context.Context, client influxdb2.Client, bucketID, orgID, name string) error {
+	_, err := client.BucketsAPI().FindBucketByID(ctx, bucketID)
+	if err == nil {
+		return fmt.Errorf("bucket still exists")
+	}
+	if !isNotFoundError(err) {
+		return err
+	}
+
+	result, err := client.APIClient().GetBuckets(ctx, &domain.GetBucketsParams{OrgID: &orgID, Name: &name})
+	if isNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if result.Buckets != nil && len(*result.Buckets) > 0 {
+		return fmt.Errorf("bucket name %s is still in use", name)
+	}
+
+	return nil
+}
+
 func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// Helper function to read bucket and populate the model
-func (r *BucketResource) readBucket(ctx context.Context, model *BucketResourceModel) error {
+// Helper function to read bucket and populate the model. The returned
+// warnings report a retention adjusted by the server.
+func (r *BucketResource) readBucket(ctx context.Context, model *BucketResourceModel) (diag.Diagnostics, error) {
 	result, err := r.client.BucketsAPI().FindBucketByID(ctx, model.ID.ValueString())
 	if err != nil {
-		return fmt.Errorf("error finding bucket: %w", err)
+		return nil, fmt.Errorf("error finding bucket: %w", err)
+	}
+
+	var warnings diag.Diagnostics
+	if planned, ok := r.plannedRetentionSeconds(ctx, model); ok {
+		warnings = retentionClampWarning(planned, retentionSecondsFromDomain(result.RetentionRules))
 	}
 
 	// Update model with data from InfluxDB
@@ -346,28 +1025,709 @@ func (r *BucketResource) readBucket(ctx context.Context, model *BucketResourceMo
 		model.RP = types.StringValue("")
 	}
 
-	if result.CreatedAt != nil {
-		model.CreatedAt = types.StringValue(result.CreatedAt.String())
+	model.CreatedAt = timestampValue(result.CreatedAt)
+	model.UpdatedAt = timestampValue(result.UpdatedAt)
+
+	if result.Type != nil {
+		model.Type = types.StringValue(string(*result.Type))
 	}
 
-	if result.UpdatedAt != nil {
-		model.UpdatedAt = types.StringValue(result.UpdatedAt.String())
+	// The protection only lives in state, imported buckets are unprotected.
+	if model.DeletionProtection.IsNull() {
+		model.DeletionProtection = types.BoolValue(false)
+	}
+	if model.CheckNameCollision.IsNull() {
+		model.CheckNameCollision = types.BoolValue(false)
+	}
+	if model.WaitForDelete.IsNull() {
+		model.WaitForDelete = types.BoolValue(false)
 	}
 
-	if result.Type != nil {
-		model.Type = types.StringValue(string(*result.Type))
+	// The server default shard group duration is only tracked once configured.
+	if !model.ShardGroupDuration.IsNull() {
+		if shardGroupDuration := shardGroupDurationFromDomain(result.RetentionRules); shardGroupDuration != nil {
+			model.ShardGroupDuration = types.Int64Value(*shardGroupDuration)
+		}
+	}
+
+	if result.SchemaType != nil {
+		model.SchemaType = types.StringValue(string(*result.SchemaType))
+	} else {
+		model.SchemaType = types.StringValue(string(domain.SchemaTypeImplicit))
 	}
 
-	// Convert retention rules
-	retentionRulesSet, err := r.convertRetentionRulesToTerraform(ctx, result.RetentionRules)
+	infinite := retentionSecondsFromDomain(result.RetentionRules) == 0
+	model.InfiniteRetention = types.BoolValue(infinite)
+
+	// Convert retention rules. When the retention_seconds shorthand or
+	// infinite_retention is in use, or the rules were copied from a clone
+	// source, the block form stays empty so it keeps matching the configuration.
+	var retentionRulesSet types.Set
+	switch {
+	case !model.RetentionSeconds.IsNull():
+		model.RetentionSeconds = types.Int64Value(retentionSecondsFromDomain(result.RetentionRules))
+		retentionRulesSet, err = r.convertRetentionRulesToTerraform(ctx, nil)
+	case infinite && len(model.RetentionRules.Elements()) == 0:
+		retentionRulesSet, err = r.convertRetentionRulesToTerraform(ctx, nil)
+	case model.retentionFromClone():
+		retentionRulesSet, err = r.convertRetentionRulesToTerraform(ctx, nil)
+	default:
+		retentionRulesSet, err = r.convertRetentionRulesToTerraform(ctx, result.RetentionRules)
+	}
 	if err != nil {
-		return fmt.Errorf("error converting retention rules: %w", err)
+		return nil, fmt.Errorf("error converting retention rules: %w", err)
 	}
 	model.RetentionRules = retentionRulesSet
 
+	// The schemas are only read once configured, so that schemas managed
+	// elsewhere do not show up as differences.
+	if len(model.MeasurementSchemas.Elements()) > 0 {
+		measurementSchemas, err := r.readMeasurementSchemas(ctx, model)
+		if err != nil {
+			return nil, fmt.Errorf("error finding measurement schemas: %w", err)
+		}
+		model.MeasurementSchemas = measurementSchemas
+	} else {
+		model.MeasurementSchemas = types.ListValueMust(measurementSchemaObjectType, []attr.Value{})
+	}
+
+	// Populate the objects associated with the bucket. Annotation labels are
+	// only reported as annotations, and only read once configured. Tokens may
+	// not be allowed to read labels or DBRP mappings, these lookups are then
+	// skipped and the previous values kept.
+	labels, err := r.readBucketLabels(ctx, model.ID.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Could not read bucket labels", map[string]any{"id": model.ID.ValueString(), "error": formatAPIError(err)})
+		if model.Labels.IsUnknown() {
+			model.Labels = types.ListNull(types.StringType)
+		}
+	} else {
+		labelIDs := []string{}
+		annotations := map[string]string{}
+		for _, label := range labels {
+			if key, value, ok := annotationFromLabelName(label.name); ok {
+				annotations[key] = value
+				continue
+			}
+			labelIDs = append(labelIDs, label.id)
+		}
+		labelsList, diags := types.ListValueFrom(ctx, types.StringType, labelIDs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error creating labels list")
+		}
+		model.Labels = labelsList
+
+		if !model.Annotations.IsNull() {
+			model.Annotations, diags = types.MapValueFrom(ctx, types.StringType, annotations)
+			if diags.HasError() {
+				return nil, fmt.Errorf("error creating annotations map")
+			}
+		}
+	}
+
+	dbrpIDs, err := r.readBucketDBRPIDs(ctx, model.ID.ValueString(), model.OrgID.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Could not read bucket DBRP mappings", map[string]any{"id": model.ID.ValueString(), "error": formatAPIError(err)})
+		if model.DBRPIDs.IsUnknown() {
+			model.DBRPIDs = types.ListNull(types.StringType)
+		}
+	} else {
+		dbrpList, diags := types.ListValueFrom(ctx, types.StringType, dbrpIDs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("error creating DBRP IDs list")
+		}
+		model.DBRPIDs = dbrpList
+	}
+
+	return warnings, nil
+}
+
+// measurementSchemaObjectType is the type of the measurement_schemas elements.
+var measurementSchemaObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+		"columns": types.ListType{ElemType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name":      types.StringType,
+				"type":      types.StringType,
+				"data_type": types.StringType,
+			},
+		}},
+	},
+}
+
+// Helper function to check the columns of a measurement schema, which need
+// exactly one timestamp column named time and a data type for fields only
+func validateMeasurementSchemaColumns(columns []MeasurementSchemaColumnModel) error {
+	timestamps := 0
+	for _, column := range columns {
+		if column.Name.IsUnknown() || column.Type.IsUnknown() || column.DataType.IsUnknown() {
+			continue
+		}
+
+		name := column.Name.ValueString()
+		switch column.Type.ValueString() {
+		case "timestamp":
+			timestamps++
+			if name != "time" {
+				return fmt.Errorf("the timestamp column must be named 'time', got: %s", name)
+			}
+		case "tag":
+		case "field":
+			switch column.DataType.ValueString() {
+			case "integer", "float", "boolean", "string", "unsigned":
+			default:
+				return fmt.Errorf("field column %s needs a data_type of 'integer', 'float', 'boolean', 'string' or 'unsigned'", name)
+			}
+			continue
+		default:
+			return fmt.Errorf("column %s has type %q, expected 'timestamp', 'tag' or 'field'", name, column.Type.ValueString())
+		}
+
+		if !column.DataType.IsNull() {
+			return fmt.Errorf("column %s is not a field and cannot have a data_type", name)
+		}
+	}
+
+	if timestamps != 1 {
+		return fmt.Errorf("exactly one timestamp column is required, got %d", timestamps)
+	}
+
+	return nil
+}
+
+// Helper function to convert the measurement schemas from Terraform List to API model
+func measurementSchemasFromModel(ctx context.Context, list types.List) ([]measurementSchema, error) {
+	var models []MeasurementSchemaModel
+	if diags := list.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil, fmt.Errorf("error converting measurement schemas list")
+	}
+
+	schemas := []measurementSchema{}
+	for _, model := range models {
+		measurement := measurementSchema{Name: model.Name.ValueString(), Columns: []measurementSchemaColumn{}}
+		for _, column := range model.Columns {
+			measurement.Columns = append(measurement.Columns, measurementSchemaColumn{
+				Name:     column.Name.ValueString(),
+				Type:     column.Type.ValueString(),
+				DataType: column.DataType.ValueString(),
+			})
+		}
+		schemas = append(schemas, measurement)
+	}
+
+	return schemas, nil
+}
+
+// Helper function to list the measurement schemas of a bucket
+func findMeasurementSchemas(ctx context.Context, client influxdb2.Client, bucketID string) ([]measurementSchema, error) {
+	var result struct {
+		MeasurementSchemas []measurementSchema `json:"measurementSchemas"`
+	}
+	if err := doAPIRequest(ctx, client, http.MethodGet, "buckets/"+bucketID+"/schema/measurements", nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.MeasurementSchemas, nil
+}
+
+// Helper function to create the missing measurement schemas of a bucket and
+// update the columns of the existing ones
+func (r *BucketResource) writeMeasurementSchemas(ctx context.Context, bucketID string, schemas []measurementSchema) error {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	existing, err := findMeasurementSchemas(ctx, r.client, bucketID)
+	if err != nil {
+		return err
+	}
+	existingIDs := map[string]string{}
+	for _, measurement := range existing {
+		existingIDs[measurement.Name] = measurement.ID
+	}
+
+	for _, measurement := range schemas {
+		tflog.Debug(ctx, "Writing measurement schema", map[string]any{"bucket_id": bucketID, "name": measurement.Name})
+
+		if id, ok := existingIDs[measurement.Name]; ok {
+			body := measurementSchema{Columns: measurement.Columns}
+			err = doAPIRequest(ctx, r.client, http.MethodPatch, "buckets/"+bucketID+"/schema/measurements/"+id, body, nil)
+		} else {
+			err = doAPIRequest(ctx, r.client, http.MethodPost, "buckets/"+bucketID+"/schema/measurements", measurement, nil)
+		}
+		if err != nil {
+			return fmt.Errorf("error writing schema of measurement %s: %w", measurement.Name, err)
+		}
+	}
+
 	return nil
 }
 
+// Helper function to read back the configured measurement schemas, in the
+// configured order. Schemas missing from the server are dropped to be
+// created again.
+func (r *BucketResource) readMeasurementSchemas(ctx context.Context, model *BucketResourceModel) (types.List, error) {
+	configured, err := measurementSchemasFromModel(ctx, model.MeasurementSchemas)
+	if err != nil {
+		return types.ListNull(measurementSchemaObjectType), err
+	}
+
+	existing, err := findMeasurementSchemas(ctx, r.client, model.ID.ValueString())
+	if err != nil {
+		return types.ListNull(measurementSchemaObjectType), err
+	}
+	byName := map[string]measurementSchema{}
+	for _, measurement := range existing {
+		byName[measurement.Name] = measurement
+	}
+
+	models := []MeasurementSchemaModel{}
+	for _, measurement := range configured {
+		found, ok := byName[measurement.Name]
+		if !ok {
+			continue
+		}
+
+		schemaModel := MeasurementSchemaModel{Name: types.StringValue(found.Name), Columns: []MeasurementSchemaColumnModel{}}
+		for _, column := range found.Columns {
+			dataType := types.StringNull()
+			if column.DataType != "" {
+				dataType = types.StringValue(column.DataType)
+			}
+			schemaModel.Columns = append(schemaModel.Columns, MeasurementSchemaColumnModel{
+				Name:     types.StringValue(column.Name),
+				Type:     types.StringValue(column.Type),
+				DataType: dataType,
+			})
+		}
+		models = append(models, schemaModel)
+	}
+
+	list, diags := types.ListValueFrom(ctx, measurementSchemaObjectType, models)
+	if diags.HasError() {
+		return types.ListNull(measurementSchemaObjectType), fmt.Errorf("error creating measurement schemas list")
+	}
+
+	return list, nil
+}
+
+// Helper function to report another bucket of the organization already using
+// the name, ignoring the bucket with the given ID
+func (r *BucketResource) checkNameCollision(ctx context.Context, orgID, name, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	result, err := r.client.APIClient().GetBuckets(ctx, &domain.GetBucketsParams{OrgID: &orgID, Name: &name})
+	if err != nil {
+		diags.AddError(
+			"Error Checking Bucket Name",
+			"Could not look up buckets named "+name+": "+formatAPIError(err),
+		)
+		return diags
+	}
+
+	if result.Buckets == nil {
+		return diags
+	}
+	for _, bucket := range *result.Buckets {
+		if bucket.Id != nil && *bucket.Id != id && bucket.Name == name {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Bucket Name Already In Use",
+				"The target name "+name+" is already in use by bucket ID "+*bucket.Id+" in organization ID "+orgID+". "+
+					"Choose another name, or import the existing bucket to manage it.",
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// bucketLabel is a label attached to a bucket.
+type bucketLabel struct {
+	id   string
+	name string
+}
+
+// Helper function to list the labels attached to a bucket
+func (r *BucketResource) readBucketLabels(ctx context.Context, bucketID string) ([]bucketLabel, error) {
+	result, err := r.client.APIClient().GetBucketsIDLabels(ctx, &domain.GetBucketsIDLabelsAllParams{BucketID: bucketID})
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []bucketLabel{}
+	if result.Labels != nil {
+		for _, label := range *result.Labels {
+			if label.Id == nil {
+				continue
+			}
+			name := ""
+			if label.Name != nil {
+				name = *label.Name
+			}
+			labels = append(labels, bucketLabel{id: *label.Id, name: name})
+		}
+	}
+
+	return labels, nil
+}
+
+// Helper function to attach the annotation labels of a bucket and detach the
+// ones no longer configured. Detached labels are kept in the organization as
+// other buckets may use them. A null map leaves the annotations untouched.
+func (r *BucketResource) writeAnnotations(ctx context.Context, bucketID, orgID string, annotationsMap types.Map) error {
+	if annotationsMap.IsNull() || annotationsMap.IsUnknown() {
+		return nil
+	}
+
+	annotations := map[string]string{}
+	if diags := annotationsMap.ElementsAs(ctx, &annotations, false); diags.HasError() {
+		return fmt.Errorf("error converting annotations map")
+	}
+	planned := map[string]bool{}
+	for key, value := range annotations {
+		planned[annotationLabelName(key, value)] = true
+	}
+
+	labels, err := r.readBucketLabels(ctx, bucketID)
+	if err != nil {
+		return err
+	}
+
+	attached := map[string]bool{}
+	for _, label := range labels {
+		if _, _, ok := annotationFromLabelName(label.name); !ok {
+			continue
+		}
+		attached[label.name] = true
+		if planned[label.name] {
+			continue
+		}
+
+		tflog.Debug(ctx, "Detaching bucket annotation label", map[string]any{"id": bucketID, "label": label.name})
+
+		err := doAPIRequest(ctx, r.client, http.MethodDelete, "buckets/"+bucketID+"/labels/"+label.id, nil, nil)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	// The organization labels are only listed when a label must be attached
+	var orgLabels map[string]string
+	for name := range planned {
+		if attached[name] {
+			continue
+		}
+
+		if orgLabels == nil {
+			result, err := r.client.LabelsAPI().FindLabelsByOrgID(ctx, orgID)
+			if err != nil {
+				return err
+			}
+			orgLabels = map[string]string{}
+			for _, label := range *result {
+				if label.Id != nil && label.Name != nil {
+					orgLabels[*label.Name] = *label.Id
+				}
+			}
+		}
+
+		labelID, ok := orgLabels[name]
+		if !ok {
+			tflog.Debug(ctx, "Creating bucket annotation label", map[string]any{"org_id": orgID, "label": name})
+
+			label, err := r.client.LabelsAPI().CreateLabelWithNameWithID(ctx, orgID, name, nil)
+			if err != nil {
+				return err
+			}
+			labelID = *label.Id
+			orgLabels[name] = labelID
+		}
+
+		tflog.Debug(ctx, "Attaching bucket annotation label", map[string]any{"id": bucketID, "label": name})
+
+		body := map[string]string{"labelID": labelID}
+		if err := doAPIRequest(ctx, r.client, http.MethodPost, "buckets/"+bucketID+"/labels", body, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helper function to list the IDs of the DBRP mappings pointing at a bucket
+func (r *BucketResource) readBucketDBRPIDs(ctx context.Context, bucketID, orgID string) ([]string, error) {
+	result, err := r.client.APIClient().GetDBRPs(ctx, &domain.GetDBRPsParams{
+		OrgID:    &orgID,
+		BucketID: &bucketID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dbrpIDs := []string{}
+	if result.Content != nil {
+		for _, dbrp := range *result.Content {
+			dbrpIDs = append(dbrpIDs, dbrp.Id)
+		}
+	}
+
+	return dbrpIDs, nil
+}
+
+// Helper function reporting whether the retention rules are left to the
+// clone source. An empty rules list on update leaves retention untouched.
+func (m *BucketResourceModel) retentionFromClone() bool {
+	return !m.CloneFromBucketID.IsNull() && m.RetentionSeconds.IsNull() && len(m.RetentionRules.Elements()) == 0 &&
+		!m.InfiniteRetention.ValueBool()
+}
+
+// infiniteRetentionModifier plans infinite_retention from the configured
+// retention when it is not set, so that it is known before apply.
+type infiniteRetentionModifier struct{}
+
+func (m infiniteRetentionModifier) Description(ctx context.Context) string {
+	return "Plans infinite_retention from the configured retention rules when not set."
+}
+
+func (m infiniteRetentionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m infiniteRetentionModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if !req.ConfigValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var plan BucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Cloned rules are only known after create and left untouched afterwards.
+	if plan.retentionFromClone() {
+		if !req.StateValue.IsNull() {
+			resp.PlanValue = req.StateValue
+		}
+		return
+	}
+
+	if !plan.RetentionSeconds.IsNull() {
+		if !plan.RetentionSeconds.IsUnknown() {
+			resp.PlanValue = types.BoolValue(plan.RetentionSeconds.ValueInt64() == 0)
+		}
+		return
+	}
+
+	if plan.RetentionRules.IsUnknown() {
+		return
+	}
+
+	var rules []RetentionRuleModel
+	resp.Diagnostics.Append(plan.RetentionRules.ElementsAs(ctx, &rules, false)...)
+	for _, rule := range rules {
+		if rule.EverySeconds.IsUnknown() {
+			return
+		}
+		if rule.EverySeconds.ValueInt64() > 0 {
+			resp.PlanValue = types.BoolValue(false)
+			return
+		}
+	}
+
+	resp.PlanValue = types.BoolValue(true)
+}
+
+// Helper function to build the domain retention rules from either the
+// retention_seconds shorthand or the retention_rules blocks, carrying the
+// shard group duration when it is set
+func (r *BucketResource) retentionRulesFromModel(ctx context.Context, model *BucketResourceModel) (domain.RetentionRules, error) {
+	ruleType := domain.RetentionRuleTypeExpire
+
+	var rules domain.RetentionRules
+	if !model.RetentionSeconds.IsNull() {
+		rules = domain.RetentionRules{
+			{
+				EverySeconds: model.RetentionSeconds.ValueInt64(),
+				Type:         &ruleType,
+			},
+		}
+	} else {
+		var err error
+		rules, err = r.convertRetentionRulesToDomain(ctx, model.RetentionRules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if model.ShardGroupDuration.IsNull() || model.ShardGroupDuration.IsUnknown() {
+		return rules, nil
+	}
+
+	// The shard group duration is part of the expire rule, without rules it
+	// is sent with an infinite one.
+	if len(rules) == 0 {
+		rules = domain.RetentionRules{{EverySeconds: 0, Type: &ruleType}}
+	}
+	shardGroupDuration := model.ShardGroupDuration.ValueInt64()
+	for i := range rules {
+		if rules[i].Type == nil || *rules[i].Type == domain.RetentionRuleTypeExpire {
+			rules[i].ShardGroupDurationSeconds = &shardGroupDuration
+		}
+	}
+
+	return rules, nil
+}
+
+// Helper function to always replace a bucket moved to another organization,
+// warning that its data is lost since InfluxDB cannot move buckets
+func bucketOrgIDRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = true
+
+	if req.PlanValue.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Bucket Replacement Destroys Data",
+		fmt.Sprintf("Changing org_id from %q to %q destroys the bucket with all its data and creates an empty bucket "+
+			"in the new organization, as InfluxDB cannot move buckets between organizations. "+
+			"To rename the bucket, change its name instead.",
+			req.StateValue.ValueString(), req.PlanValue.ValueString()),
+	)
+}
+
+// Helper function to name the label storing an annotation
+func annotationLabelName(key, value string) string {
+	return annotationLabelPrefix + key + "=" + value
+}
+
+// Helper function to parse an annotation from a label name, reporting false
+// for labels that are not annotations
+func annotationFromLabelName(name string) (string, string, bool) {
+	if !strings.HasPrefix(name, annotationLabelPrefix) {
+		return "", "", false
+	}
+	key, value, found := strings.Cut(strings.TrimPrefix(name, annotationLabelPrefix), "=")
+	if !found || key == "" {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// Helper function to check that annotation keys can be stored in label names
+func validateAnnotations(ctx context.Context, annotationsMap types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if annotationsMap.IsNull() || annotationsMap.IsUnknown() {
+		return diags
+	}
+
+	for key := range annotationsMap.Elements() {
+		if key == "" || strings.Contains(key, "=") {
+			diags.AddAttributeError(
+				path.Root("annotations"),
+				"Invalid Annotation Key",
+				fmt.Sprintf("Annotation keys must not be empty nor contain '=', got: %q", key),
+			)
+		}
+	}
+
+	return diags
+}
+
+// Helper function to always replace a bucket changing schema type, warning
+// that its data is lost since InfluxDB cannot convert a bucket in place
+func bucketSchemaTypeRequiresReplace(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = true
+
+	if req.PlanValue.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Bucket Replacement Destroys Data",
+		fmt.Sprintf("Changing schema_type from %q to %q destroys the bucket with all its data and creates an empty "+
+			"bucket, as InfluxDB cannot change the schema type of an existing bucket.",
+			req.StateValue.ValueString(), req.PlanValue.ValueString()),
+	)
+}
+
+// Helper function to extract the shard group duration of the first expire rule
+func shardGroupDurationFromDomain(domainRules domain.RetentionRules) *int64 {
+	for _, rule := range domainRules {
+		if rule.Type == nil || *rule.Type == domain.RetentionRuleTypeExpire {
+			return rule.ShardGroupDurationSeconds
+		}
+	}
+
+	return nil
+}
+
+// Helper function to get the retention duration requested by the model, zero
+// meaning infinite. It is not known when the rules are copied from a clone
+// source or have not been read yet, as after an import.
+func (r *BucketResource) plannedRetentionSeconds(ctx context.Context, model *BucketResourceModel) (int64, bool) {
+	if !model.RetentionSeconds.IsNull() {
+		return model.RetentionSeconds.ValueInt64(), !model.RetentionSeconds.IsUnknown()
+	}
+
+	if len(model.RetentionRules.Elements()) > 0 {
+		rules, err := r.convertRetentionRulesToDomain(ctx, model.RetentionRules)
+		if err != nil {
+			return 0, false
+		}
+		return retentionSecondsFromDomain(rules), true
+	}
+
+	if model.InfiniteRetention.ValueBool() && !model.retentionFromClone() {
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// Helper function to warn when the server retained data for a shorter
+// duration than requested, as InfluxDB Cloud does with the retention limit of
+// the organization plan
+func retentionClampWarning(planned, actual int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if actual == 0 || planned == actual || (planned != 0 && actual > planned) {
+		return diags
+	}
+
+	requested := "infinite retention"
+	if planned != 0 {
+		requested = fmt.Sprintf("a retention of %d seconds", planned)
+	}
+
+	diags.AddWarning(
+		"Bucket Retention Adjusted By Server",
+		fmt.Sprintf("The bucket was configured with %s but InfluxDB applied a retention of %d seconds. "+
+			"This usually means the retention limit of the organization, such as the 30 day limit of the InfluxDB Cloud "+
+			"free plan, clamped it. Set the retention to at most %d seconds to avoid a difference on every plan.",
+			requested, actual, actual),
+	)
+
+	return diags
+}
+
+// Helper function to extract the duration of the first expire rule, zero
+// when no rule expires data
+func retentionSecondsFromDomain(domainRules domain.RetentionRules) int64 {
+	for _, rule := range domainRules {
+		if (rule.Type == nil || *rule.Type == domain.RetentionRuleTypeExpire) && rule.EverySeconds > 0 {
+			return rule.EverySeconds
+		}
+	}
+
+	return 0
+}
+
 // Helper function to convert retention rules from Terraform Set to domain model
 func (r *BucketResource) convertRetentionRulesToDomain(ctx context.Context, rulesSet types.Set) (domain.RetentionRules, error) {
 	var rules []RetentionRuleModel
@@ -388,6 +1748,26 @@ func (r *BucketResource) convertRetentionRulesToDomain(ctx context.Context, rule
 	return domainRules, nil
 }
 
+// Helper function to put retention rules read from the server in a canonical
+// form, with defaulted fields filled in, so that refreshes do not report
+// differences. The shard group duration defaulted by the server is dropped,
+// it is tracked by shard_group_duration_seconds.
+func canonicalRetentionRules(domainRules domain.RetentionRules) domain.RetentionRules {
+	rules := make(domain.RetentionRules, 0, len(domainRules))
+	for _, rule := range domainRules {
+		ruleType := domain.RetentionRuleTypeExpire
+		if rule.Type != nil {
+			ruleType = *rule.Type
+		}
+		rules = append(rules, domain.RetentionRule{
+			EverySeconds: rule.EverySeconds,
+			Type:         &ruleType,
+		})
+	}
+
+	return rules
+}
+
 // Helper function to convert retention rules from domain model to Terraform Set
 func (r *BucketResource) convertRetentionRulesToTerraform(ctx context.Context, domainRules domain.RetentionRules) (types.Set, error) {
 	retentionRuleType := types.ObjectType{
@@ -398,7 +1778,7 @@ func (r *BucketResource) convertRetentionRulesToTerraform(ctx context.Context, d
 	}
 
 	elements := []attr.Value{}
-	for _, rule := range domainRules {
+	for _, rule := range canonicalRetentionRules(domainRules) {
 		ruleTypeValue := "expire"
 		if rule.Type != nil {
 			ruleTypeValue = string(*rule.Type)
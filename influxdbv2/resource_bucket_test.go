@@ -1,12 +1,31 @@
 package influxdbv2
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
 func TestAccBucketResource(t *testing.T) {
@@ -24,9 +43,11 @@ func TestAccBucketResource(t *testing.T) {
 					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "description", "Test bucket description"),
 					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "org_id", orgID),
 					resource.TestCheckResourceAttrSet("influxdb-v2_bucket.test", "id"),
-					resource.TestCheckResourceAttrSet("influxdb-v2_bucket.test", "created_at"),
+					resource.TestMatchResourceAttr("influxdb-v2_bucket.test", "created_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`)),
 					resource.TestCheckResourceAttrSet("influxdb-v2_bucket.test", "updated_at"),
 					resource.TestCheckResourceAttrSet("influxdb-v2_bucket.test", "type"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "labels.#", "0"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "dbrp_ids.#", "0"),
 				),
 			},
 			// ImportState testing
@@ -66,6 +87,39 @@ func TestAccBucketResource_WithRetentionPolicy(t *testing.T) {
 	})
 }
 
+func TestAccBucketResource_UpdateRetentionRule(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfig("test-bucket-update-rule", "Updated rule", orgID, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("influxdb-v2_bucket.test", "retention_rules.*", map[string]string{
+						"every_seconds": "3600",
+					}),
+				),
+			},
+			{
+				Config: testAccBucketResourceConfig("test-bucket-update-rule", "Updated rule", orgID, 7200),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdb-v2_bucket.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "retention_rules.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("influxdb-v2_bucket.test", "retention_rules.*", map[string]string{
+						"every_seconds": "7200",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBucketResource_MultipleRetentionRules(t *testing.T) {
 	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
 
@@ -74,15 +128,535 @@ func TestAccBucketResource_MultipleRetentionRules(t *testing.T) {
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccBucketResourceConfigMultipleRules("test-bucket-multi", "Multiple rules", orgID),
+				Config: testAccBucketResourceConfigMultipleRules("test-bucket-multi", "Multiple rules", orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "name", "test-bucket-multi"),
+					resource.TestCheckTypeSetElemNestedAttrs("influxdb-v2_bucket.test", "retention_rules.*", map[string]string{
+						"every_seconds": "3600",
+						"type":          "expire",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_RetentionSeconds(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfigRetentionSeconds("test-bucket-retention-seconds", orgID, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "retention_seconds", "3600"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "retention_rules.#", "0"),
+				),
+			},
+			{
+				Config: testAccBucketResourceConfigRetentionSeconds("test-bucket-retention-seconds", orgID, 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "retention_seconds", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_RetentionSecondsConflict(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name              = "test-bucket-conflict"
+  org_id            = %[1]q
+  retention_seconds = 3600
+
+  retention_rules {
+    every_seconds = 3600
+  }
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Conflicting Retention Configuration`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_InfiniteRetention(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfigRetentionSeconds("test-bucket-infinite-retention", orgID, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "infinite_retention", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name               = "test-bucket-infinite-retention"
+  org_id             = %[1]q
+  infinite_retention = true
+}
+`, orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "infinite_retention", "true"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "retention_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_InfiniteRetentionConflict(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name               = "test-bucket-infinite-conflict"
+  org_id             = %[1]q
+  infinite_retention = true
+
+  retention_rules {
+    every_seconds = 3600
+  }
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Conflicting Retention Configuration`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_DeletionProtection(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfigDeletionProtection(orgID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "deletion_protection", "true"),
+				),
+			},
+			// Removing the protected bucket from the configuration fails
+			{
+				Config:      testAccProviderConfigDataSourceConfig,
+				ExpectError: regexp.MustCompile(`Bucket Deletion Protected`),
+			},
+			// Lift the protection so that the bucket can be destroyed
+			{
+				Config: testAccBucketResourceConfigDeletionProtection(orgID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_TypeNotConfigurable(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfig("test-bucket-type", "Bucket type", orgID, 3600),
+			},
+			{
+				ResourceName:      "influxdb-v2_bucket.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name        = "test-bucket-type"
+  description = "Bucket type"
+  org_id      = %[1]q
+  type        = "user"
+
+  retention_rules {
+    every_seconds = 3600
+    type          = "expire"
+  }
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Bucket Type Is Server-Assigned`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_CloneFromBucket(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_bucket" "source" {
+  name   = "test-bucket-clone-source"
+  org_id = %[1]q
+
+  retention_rules {
+    every_seconds = 7200
+  }
+}
+
+resource "influxdb-v2_bucket" "test" {
+  name                 = "test-bucket-clone"
+  org_id               = %[1]q
+  clone_from_bucket_id = influxdb-v2_bucket.source.id
+}
+`, orgID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("influxdb-v2_bucket.test", "clone_from_bucket_id", "influxdb-v2_bucket.source", "id"),
+					resource.TestCheckResourceAttrPair("influxdb-v2_bucket.test", "schema_type", "influxdb-v2_bucket.source", "schema_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_CloneFromMissingBucket(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name                 = "test-bucket-clone-missing"
+  org_id               = %[1]q
+  clone_from_bucket_id = "0000000000000000"
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Error Reading Source Bucket`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_ShardGroupDuration(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfigShardGroupDuration(orgID, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "shard_group_duration_seconds", "3600"),
+				),
+			},
+			// Changing only the shard group duration updates the bucket in place
+			{
+				Config: testAccBucketResourceConfigShardGroupDuration(orgID, 7200),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdb-v2_bucket.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "shard_group_duration_seconds", "7200"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "retention_seconds", "86400"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_ShardGroupDurationLongerThanRetention(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketResourceConfigShardGroupDuration(orgID, 172800),
+				ExpectError: regexp.MustCompile(`Invalid Shard Group Duration`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_ImportNoDrift(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	config := testAccBucketResourceConfigMultipleRules("test-bucket-no-drift", "No drift", orgID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      "influxdb-v2_bucket.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Refreshing twice must not plan any change
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_RPNotSet(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	config := testAccBucketResourceConfig("test-bucket-rp-not-set", "No rp", orgID, 3600)
+	updated := testAccBucketResourceConfig("test-bucket-rp-not-set", "Updated", orgID, 3600)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// The retention policy name assigned by the server must not plan any change
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Nor after updating another attribute
+			{
+				Config: updated,
+			},
+			{
+				Config:   updated,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_RenameNameCollision(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfigNameCollision(orgID, "test-bucket-collision-b"),
+			},
+			{
+				Config:      testAccBucketResourceConfigNameCollision(orgID, "test-bucket-collision-a"),
+				ExpectError: regexp.MustCompile(`Bucket Name Already In Use`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_ValidateOrg(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "influxdb-v2" {
+  validate_org = true
+}
+
+resource "influxdb-v2_bucket" "test" {
+  name   = "test-bucket-validate-org"
+  org_id = "000000000000000a"
+}
+`,
+				ExpectError: regexp.MustCompile(`Organization Not Found`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_SystemBucket(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The system bucket is released with a removed block instead of being destroyed
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfigSystemLookup(orgID),
+			},
+			{
+				Config:       testAccBucketResourceConfigSystem(orgID, 604800),
+				ResourceName: "influxdb-v2_bucket.monitoring",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["data.influxdb-v2_bucket.monitoring"].Primary.ID, nil
+				},
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccBucketResourceConfigSystem(orgID, 1209600),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "name", "test-bucket-multi"),
-					resource.TestCheckTypeSetElemNestedAttrs("influxdb-v2_bucket.test", "retention_rules.*", map[string]string{
-						"every_seconds": "3600",
-						"type":          "expire",
-					}),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.monitoring", "type", "system"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.monitoring", "retention_seconds", "1209600"),
+				),
+			},
+			// Restore the default retention of the system bucket
+			{
+				Config: testAccBucketResourceConfigSystem(orgID, 604800),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.monitoring", "retention_seconds", "604800"),
+				),
+			},
+			{
+				Config:      testAccBucketResourceConfigSystemLookup(orgID),
+				ExpectError: regexp.MustCompile(`System Bucket Cannot Be Deleted`),
+			},
+			{
+				Config: testAccBucketResourceConfigSystemLookup(orgID) + `
+removed {
+  from = influxdb-v2_bucket.monitoring
+
+  lifecycle {
+    destroy = false
+  }
+}
+`,
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_DefaultSchemaType(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketResourceConfigDefaultSchemaType(orgID, "strict", ""),
+				ExpectError: regexp.MustCompile(`Invalid InfluxDB Default Schema Type`),
+			},
+			{
+				Config: testAccBucketResourceConfigDefaultSchemaType(orgID, "explicit", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "schema_type", "explicit"),
+				),
+			},
+			// An explicit value on the bucket overrides the provider default,
+			// changing the schema type replaces the bucket
+			{
+				Config: testAccBucketResourceConfigDefaultSchemaType(orgID, "explicit", "implicit"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("influxdb-v2_bucket.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "schema_type", "implicit"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_MeasurementSchemas(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Measurement schemas need an explicit schema type
+			{
+				Config:      testAccBucketResourceConfigMeasurementSchemas(orgID, "implicit", "timestamp"),
+				ExpectError: regexp.MustCompile(`Invalid Measurement Schemas`),
+			},
+			{
+				Config:      testAccBucketResourceConfigMeasurementSchemas(orgID, "explicit", "tag"),
+				ExpectError: regexp.MustCompile(`exactly one timestamp column is required`),
+			},
+			// The acceptance tests run against InfluxDB OSS, which has no measurement schemas
+			{
+				Config:      testAccBucketResourceConfigMeasurementSchemas(orgID, "explicit", "timestamp"),
+				ExpectError: regexp.MustCompile(`Unsupported InfluxDB Edition`),
+			},
+		},
+	})
+}
+
+func TestAccBucketResource_Annotations(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	testAccCleanupAnnotationLabels(t, orgID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketResourceConfigAnnotations(orgID, `{ team = "platform", cost_center = "42" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "annotations.%", "2"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "annotations.team", "platform"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "annotations.cost_center", "42"),
+					// Annotation labels are not reported as labels
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "labels.#", "0"),
+				),
+			},
+			// Changing a value swaps its label, removing a key detaches its label
+			{
+				Config: testAccBucketResourceConfigAnnotations(orgID, `{ team = "data" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "annotations.%", "1"),
+					resource.TestCheckResourceAttr("influxdb-v2_bucket.test", "annotations.team", "data"),
 				),
 			},
+			{
+				ResourceName:            "influxdb-v2_bucket.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"annotations"},
+			},
+			{
+				Config:      testAccBucketResourceConfigAnnotations(orgID, `{ "team=" = "data" }`),
+				ExpectError: regexp.MustCompile(`Invalid Annotation Key`),
+			},
 		},
 	})
 }
@@ -102,6 +676,26 @@ resource "influxdb-v2_bucket" "test" {
 `, name, description, orgID, everySeconds)
 }
 
+func testAccBucketResourceConfigSystemLookup(orgID string) string {
+	return fmt.Sprintf(`
+data "influxdb-v2_bucket" "monitoring" {
+  name   = "_monitoring"
+  org_id = %[1]q
+}
+`, orgID)
+}
+
+func testAccBucketResourceConfigSystem(orgID string, retentionSeconds int) string {
+	return testAccBucketResourceConfigSystemLookup(orgID) + fmt.Sprintf(`
+resource "influxdb-v2_bucket" "monitoring" {
+  name              = "_monitoring"
+  org_id            = %[1]q
+  description       = data.influxdb-v2_bucket.monitoring.description
+  retention_seconds = %[2]d
+}
+`, orgID, retentionSeconds)
+}
+
 func testAccBucketResourceConfigWithRP(name, description, orgID string, everySeconds int, rp string) string {
 	return fmt.Sprintf(`
 resource "influxdb-v2_bucket" "test" {
@@ -133,7 +727,129 @@ resource "influxdb-v2_bucket" "test" {
 `, name, description, orgID)
 }
 
+func testAccBucketResourceConfigRetentionSeconds(name, orgID string, retentionSeconds int) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name              = %[1]q
+  org_id            = %[2]q
+  retention_seconds = %[3]d
+}
+`, name, orgID, retentionSeconds)
+}
+
+func testAccBucketResourceConfigDeletionProtection(orgID string, protected bool) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name                = "test-bucket-deletion-protection"
+  org_id              = %[1]q
+  deletion_protection = %[2]t
+}
+`, orgID, protected)
+}
+
+func testAccBucketResourceConfigShardGroupDuration(orgID string, shardGroupDuration int) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name                         = "test-bucket-shard-group"
+  org_id                       = %[1]q
+  retention_seconds            = 86400
+  shard_group_duration_seconds = %[2]d
+}
+`, orgID, shardGroupDuration)
+}
+
+func testAccBucketResourceConfigNameCollision(orgID, name string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "existing" {
+  name   = "test-bucket-collision-a"
+  org_id = %[1]q
+}
+
+resource "influxdb-v2_bucket" "test" {
+  name                 = %[2]q
+  org_id               = %[1]q
+  check_name_collision = true
+
+  depends_on = [influxdb-v2_bucket.existing]
+}
+`, orgID, name)
+}
+
+func testAccBucketResourceConfigDefaultSchemaType(orgID, defaultSchemaType, schemaType string) string {
+	schemaTypeAttribute := ""
+	if schemaType != "" {
+		schemaTypeAttribute = fmt.Sprintf("schema_type = %q", schemaType)
+	}
+
+	return fmt.Sprintf(`
+provider "influxdb-v2" {
+  default_schema_type = %[2]q
+}
+
+resource "influxdb-v2_bucket" "test" {
+  name   = "test-bucket-default-schema-type"
+  org_id = %[1]q
+  %[3]s
+}
+`, orgID, defaultSchemaType, schemaTypeAttribute)
+}
+
+func testAccBucketResourceConfigMeasurementSchemas(orgID, schemaType, timeColumnType string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name        = "test-bucket-measurement-schemas"
+  org_id      = %[1]q
+  schema_type = %[2]q
+
+  measurement_schemas {
+    name = "cpu"
+
+    columns {
+      name = "time"
+      type = %[3]q
+    }
+    columns {
+      name = "host"
+      type = "tag"
+    }
+    columns {
+      name      = "usage_user"
+      type      = "field"
+      data_type = "float"
+    }
+  }
+}
+`, orgID, schemaType, timeColumnType)
+}
+
 // Helper function to check if bucket exists
+func testAccBucketResourceConfigAnnotations(orgID, annotations string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_bucket" "test" {
+  name        = "test-bucket-annotations"
+  org_id      = %[1]q
+  annotations = %[2]s
+}
+`, orgID, annotations)
+}
+
+// Helper function to delete the annotation labels left in the organization
+// at the end of the test
+func testAccCleanupAnnotationLabels(t *testing.T, orgID string) {
+	t.Cleanup(func() {
+		client := testAccClient(t)
+		labels, err := client.LabelsAPI().FindLabelsByOrgID(context.Background(), orgID)
+		if err != nil {
+			return
+		}
+		for _, label := range *labels {
+			if label.Name != nil && strings.HasPrefix(*label.Name, annotationLabelPrefix) {
+				_ = client.LabelsAPI().DeleteLabelWithID(context.Background(), *label.Id)
+			}
+		}
+	})
+}
+
 func testAccCheckBucketExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -148,3 +864,395 @@ func testAccCheckBucketExists(resourceName string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestRetentionClampWarning(t *testing.T) {
+	tests := []struct {
+		planned int64
+		actual  int64
+		warning bool
+	}{
+		{planned: 3600, actual: 3600, warning: false},
+		{planned: 0, actual: 0, warning: false},
+		// InfluxDB Cloud free plan, capped to 30 days
+		{planned: 7776000, actual: 2592000, warning: true},
+		{planned: 0, actual: 2592000, warning: true},
+		// Rounded up to the minimum retention
+		{planned: 60, actual: 3600, warning: false},
+	}
+
+	for _, test := range tests {
+		diags := retentionClampWarning(test.planned, test.actual)
+		if diags.HasError() {
+			t.Errorf("retentionClampWarning(%d, %d) returned an error", test.planned, test.actual)
+		}
+		if warning := diags.WarningsCount() > 0; warning != test.warning {
+			t.Errorf("retentionClampWarning(%d, %d) warning = %t; expected %t", test.planned, test.actual, warning, test.warning)
+		}
+	}
+}
+
+func TestBucketOrgIDRequiresReplace(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    types.String
+		warning bool
+	}{
+		{name: "known organization", plan: types.StringValue("new-org"), warning: true},
+		{name: "unknown organization", plan: types.StringUnknown(), warning: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:       path.Root("org_id"),
+				StateValue: types.StringValue("old-org"),
+				PlanValue:  test.plan,
+			}
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+
+			bucketOrgIDRequiresReplace(context.Background(), req, resp)
+
+			if !resp.RequiresReplace {
+				t.Error("expected the bucket to be replaced")
+			}
+			if warning := resp.Diagnostics.WarningsCount() > 0; warning != test.warning {
+				t.Errorf("warning = %t; expected %t", warning, test.warning)
+			}
+		})
+	}
+}
+
+func TestBucketSchemaTypeRequiresReplace(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    types.String
+		warning bool
+	}{
+		{name: "known schema type", plan: types.StringValue("explicit"), warning: true},
+		{name: "unknown schema type", plan: types.StringUnknown(), warning: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:       path.Root("schema_type"),
+				StateValue: types.StringValue("implicit"),
+				PlanValue:  test.plan,
+			}
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+
+			bucketSchemaTypeRequiresReplace(context.Background(), req, resp)
+
+			if !resp.RequiresReplace {
+				t.Error("expected the bucket to be replaced")
+			}
+			if warning := resp.Diagnostics.WarningsCount() > 0; warning != test.warning {
+				t.Errorf("warning = %t; expected %t", warning, test.warning)
+			}
+		})
+	}
+}
+
+func TestCanonicalRetentionRules(t *testing.T) {
+	expire := domain.RetentionRuleTypeExpire
+	shardGroupDuration := int64(3600)
+
+	rules := canonicalRetentionRules(domain.RetentionRules{
+		{EverySeconds: 86400, Type: &expire},
+		{EverySeconds: 3600, ShardGroupDurationSeconds: &shardGroupDuration},
+	})
+
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+	if rules[0].EverySeconds != 86400 || rules[1].EverySeconds != 3600 {
+		t.Errorf("expected the durations to be kept, got %d and %d", rules[0].EverySeconds, rules[1].EverySeconds)
+	}
+	for _, rule := range rules {
+		if rule.Type == nil || *rule.Type != expire {
+			t.Errorf("expected the rule type to default to %q", expire)
+		}
+		if rule.ShardGroupDurationSeconds != nil {
+			t.Error("expected the shard group duration to be dropped")
+		}
+	}
+}
+
+func TestValidateMeasurementSchemaColumns(t *testing.T) {
+	column := func(name, columnType string, dataType types.String) MeasurementSchemaColumnModel {
+		return MeasurementSchemaColumnModel{Name: types.StringValue(name), Type: types.StringValue(columnType), DataType: dataType}
+	}
+	timeColumn := column("time", "timestamp", types.StringNull())
+
+	tests := []struct {
+		name    string
+		columns []MeasurementSchemaColumnModel
+		valid   bool
+	}{
+		{
+			name:    "valid",
+			columns: []MeasurementSchemaColumnModel{timeColumn, column("host", "tag", types.StringNull()), column("usage", "field", types.StringValue("float"))},
+			valid:   true,
+		},
+		{
+			name:    "missing timestamp",
+			columns: []MeasurementSchemaColumnModel{column("host", "tag", types.StringNull())},
+		},
+		{
+			name:    "two timestamps",
+			columns: []MeasurementSchemaColumnModel{timeColumn, timeColumn},
+		},
+		{
+			name:    "timestamp not named time",
+			columns: []MeasurementSchemaColumnModel{column("ts", "timestamp", types.StringNull())},
+		},
+		{
+			name:    "field without data type",
+			columns: []MeasurementSchemaColumnModel{timeColumn, column("usage", "field", types.StringNull())},
+		},
+		{
+			name:    "tag with data type",
+			columns: []MeasurementSchemaColumnModel{timeColumn, column("host", "tag", types.StringValue("string"))},
+		},
+		{
+			name:    "unknown column type",
+			columns: []MeasurementSchemaColumnModel{timeColumn, column("host", "label", types.StringNull())},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateMeasurementSchemaColumns(test.columns)
+			if (err == nil) != test.valid {
+				t.Errorf("expected valid %t, got error %v", test.valid, err)
+			}
+		})
+	}
+}
+
+func TestAnnotationFromLabelName(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		value string
+		ok    bool
+	}{
+		{name: annotationLabelName("team", "platform"), key: "team", value: "platform", ok: true},
+		{name: "tf:url=http://host/?a=b", key: "url", value: "http://host/?a=b", ok: true},
+		{name: "tf:empty=", key: "empty", value: "", ok: true},
+		{name: "tf:=value"},
+		{name: "tf:team"},
+		{name: "production"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, value, ok := annotationFromLabelName(test.name)
+			if ok != test.ok || key != test.key || value != test.value {
+				t.Errorf("expected (%q, %q, %t), got (%q, %q, %t)", test.key, test.value, test.ok, key, value, ok)
+			}
+		})
+	}
+}
+
+func TestWaitForBucketDeletion(t *testing.T) {
+	tests := map[string]struct {
+		foundByID   int32
+		foundByName int32
+		timeout     time.Duration
+		expectErr   bool
+	}{
+		"already gone": {
+			timeout: time.Second,
+		},
+		"delayed deletion": {
+			foundByID: 3,
+			timeout:   time.Second,
+		},
+		"name still in use": {
+			foundByName: 2,
+			timeout:     time.Second,
+		},
+		"delayed deletion and name release": {
+			foundByID:   2,
+			foundByName: 2,
+			timeout:     time.Second,
+		},
+		"timeout": {
+			foundByID: 1000,
+			timeout:   50 * time.Millisecond,
+			expectErr: true,
+		},
+		"name timeout": {
+			foundByName: 1000,
+			timeout:     50 * time.Millisecond,
+			expectErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var idCalls, nameCalls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v2/buckets/0000000000000001":
+					if atomic.AddInt32(&idCalls, 1) > test.foundByID {
+						w.WriteHeader(http.StatusNotFound)
+						fmt.Fprint(w, `{"code":"not found","message":"bucket not found"}`)
+						return
+					}
+					fmt.Fprint(w, `{"id":"0000000000000001","name":"deleted","retentionRules":[]}`)
+				case "/api/v2/buckets":
+					if r.URL.Query().Get("name") != "deleted" || r.URL.Query().Get("orgID") != "94d518926178fea7" {
+						t.Errorf("unexpected bucket lookup: %s", r.URL.RawQuery)
+					}
+					if atomic.AddInt32(&nameCalls, 1) > test.foundByName {
+						fmt.Fprint(w, `{"buckets":[]}`)
+						return
+					}
+					fmt.Fprint(w, `{"buckets":[{"id":"0000000000000001","name":"deleted","retentionRules":[]}]}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			client := influxdb2.NewClient(server.URL, "token")
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+
+			err := waitForBucketDeletion(ctx, client, "0000000000000001", "94d518926178fea7", "deleted", 5*time.Millisecond)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error %t, got: %v", test.expectErr, err)
+			}
+			if test.expectErr {
+				return
+			}
+			// The name is only looked up once the ID is gone
+			if calls := atomic.LoadInt32(&nameCalls); calls != test.foundByName+1 {
+				t.Errorf("expected %d name lookups, got %d", test.foundByName+1, calls)
+			}
+			if calls := atomic.LoadInt32(&idCalls); calls != test.foundByID+test.foundByName+1 {
+				t.Errorf("expected %d ID lookups, got %d", test.foundByID+test.foundByName+1, calls)
+			}
+		})
+	}
+}
+
+func TestUpdateSystemBucket(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/v2/buckets/0000000000000001" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id":"0000000000000001","name":"_monitoring","type":"system","retentionRules":[]}`)
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	id := "0000000000000001"
+	description := "System bucket for monitoring logs"
+	ruleType := domain.RetentionRuleTypeExpire
+	err := updateSystemBucket(context.Background(), client, &domain.Bucket{
+		Id:             &id,
+		Name:           "_monitoring",
+		Description:    &description,
+		RetentionRules: domain.RetentionRules{{EverySeconds: 1209600, Type: &ruleType}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := body["name"]; ok {
+		t.Errorf("expected the name to be left out of the request, got: %v", body)
+	}
+	rules, _ := body["retentionRules"].([]any)
+	if len(rules) != 1 || rules[0].(map[string]any)["everySeconds"] != float64(1209600) {
+		t.Errorf("unexpected retention rules: %v", body["retentionRules"])
+	}
+}
+
+func TestReadBucket_ForbiddenAssociations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/buckets/0000000000000001":
+			fmt.Fprint(w, `{"id":"0000000000000001","orgID":"94d518926178fea7","name":"telegraf","type":"user","retentionRules":[]}`)
+		case "/api/v2/buckets/0000000000000001/labels":
+			fmt.Fprint(w, `{"labels":[{"id":"0000000000000002","name":"production"}]}`)
+		case "/api/v2/dbrps":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"code":"forbidden","message":"insufficient permissions for read:dbrp"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	r := &BucketResource{client: client}
+	model := BucketResourceModel{
+		ID:      types.StringValue("0000000000000001"),
+		Labels:  types.ListUnknown(types.StringType),
+		DBRPIDs: types.ListUnknown(types.StringType),
+	}
+
+	if _, err := r.readBucket(context.Background(), &model); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if model.Name.ValueString() != "telegraf" {
+		t.Errorf("expected the bucket to be read, got name %q", model.Name.ValueString())
+	}
+	if len(model.Labels.Elements()) != 1 {
+		t.Errorf("expected 1 label, got %v", model.Labels)
+	}
+	if !model.DBRPIDs.IsNull() {
+		t.Errorf("expected unreadable DBRP IDs to be null, got %v", model.DBRPIDs)
+	}
+}
+
+func TestSaveCreatedBucketID(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	(&BucketResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// Computed attributes are unknown in the plan of a new bucket
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, tftypes.UnknownValue)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "telegraf")
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+
+	diags := saveCreatedBucketID(ctx, plan, &state, types.StringValue("0000000000000001"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !state.Raw.IsFullyKnown() {
+		t.Errorf("expected no unknown value in state, got: %s", state.Raw)
+	}
+	var id, name types.String
+	state.GetAttribute(ctx, path.Root("id"), &id)
+	state.GetAttribute(ctx, path.Root("name"), &name)
+	if id.ValueString() != "0000000000000001" || name.ValueString() != "telegraf" {
+		t.Errorf("expected the ID and planned name in state, got %s and %s", id, name)
+	}
+}
@@ -0,0 +1,411 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BucketsResource{}
+var _ resource.ResourceWithValidateConfig = &BucketsResource{}
+
+func NewBucketsResource() resource.Resource {
+	return &BucketsResource{}
+}
+
+// BucketsResource defines the resource implementation.
+type BucketsResource struct {
+	client influxdb2.Client
+}
+
+// BucketsResourceModel describes the resource data model.
+type BucketsResourceModel struct {
+	ID      types.String                        `tfsdk:"id"`
+	OrgID   types.String                        `tfsdk:"org_id"`
+	Buckets map[string]BucketsResourceItemModel `tfsdk:"buckets"`
+}
+
+// BucketsResourceItemModel describes a single bucket managed by the resource,
+// keyed by its name.
+type BucketsResourceItemModel struct {
+	ID               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	RetentionSeconds types.Int64  `tfsdk:"retention_seconds"`
+}
+
+func (r *BucketsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_buckets"
+}
+
+func (r *BucketsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of InfluxDB v2 buckets of an organization as a single resource, keyed by bucket name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource (the organization ID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID owning the buckets.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"buckets": schema.MapNestedAttribute{
+				Description: "The buckets, by name. Buckets added to the map are created, and buckets removed from it are deleted.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the bucket.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the bucket.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(""),
+						},
+						"retention_seconds": schema.Int64Attribute{
+							Description: "The duration in seconds for how long data is kept in the bucket, 0 to keep it forever. Defaults to 0.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *BucketsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BucketsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, bucket := range config.Buckets {
+		if bucket.RetentionSeconds.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("buckets").AtMapKey(name).AtName("retention_seconds"),
+				"Invalid Retention Seconds",
+				"The retention_seconds of bucket "+name+" must not be negative.",
+			)
+		}
+	}
+}
+
+func (r *BucketsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *BucketsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, _, _ := diffBucketsMap(plan.Buckets, nil)
+
+	tflog.Debug(ctx, "Creating buckets", map[string]any{"org_id": plan.OrgID.ValueString(), "names": created})
+
+	// Buckets created before a failure are saved so that they are tracked,
+	// the resource is then tainted and replaced on the next apply.
+	current := BucketsResourceModel{
+		ID:      plan.OrgID,
+		OrgID:   plan.OrgID,
+		Buckets: map[string]BucketsResourceItemModel{},
+	}
+	for _, name := range created {
+		bucket, err := r.createBucket(ctx, plan.OrgID.ValueString(), name, plan.Buckets[name])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Buckets",
+				"Could not create bucket "+name+": "+formatAPIError(err),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+			return
+		}
+		current.Buckets[name] = bucket
+	}
+
+	tflog.Trace(ctx, "Created buckets", map[string]any{"org_id": plan.OrgID.ValueString(), "count": len(created)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *BucketsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, item := range state.Buckets {
+		bucket, err := r.client.BucketsAPI().FindBucketByID(ctx, item.ID.ValueString())
+		if isNotFoundError(err) {
+			tflog.Debug(ctx, "Bucket deleted outside of Terraform", map[string]any{"name": name, "id": item.ID.ValueString()})
+			delete(state.Buckets, name)
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Buckets",
+				"Could not read bucket "+name+": "+formatAPIError(err),
+			)
+			return
+		}
+
+		// A renamed bucket no longer matches its key, the named bucket is
+		// created again on the next apply.
+		if bucket.Name != name {
+			tflog.Debug(ctx, "Bucket renamed outside of Terraform", map[string]any{"name": name, "new_name": bucket.Name})
+			delete(state.Buckets, name)
+			continue
+		}
+
+		state.Buckets[name] = bucketsResourceItemFromDomain(bucket)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *BucketsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BucketsResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, updated, removed := diffBucketsMap(plan.Buckets, state.Buckets)
+
+	tflog.Debug(ctx, "Updating buckets", map[string]any{
+		"org_id":  plan.OrgID.ValueString(),
+		"created": created,
+		"updated": updated,
+		"removed": removed,
+	})
+
+	// The state follows each change, so that it matches InfluxDB when a
+	// change fails half way. Removals go first to free their names.
+	current := BucketsResourceModel{
+		ID:      plan.OrgID,
+		OrgID:   plan.OrgID,
+		Buckets: map[string]BucketsResourceItemModel{},
+	}
+	for name, bucket := range state.Buckets {
+		current.Buckets[name] = bucket
+	}
+
+	for _, name := range removed {
+		err := r.client.BucketsAPI().DeleteBucketWithID(ctx, state.Buckets[name].ID.ValueString())
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Updating Buckets",
+				"Could not delete bucket "+name+": "+formatAPIError(err),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+			return
+		}
+		delete(current.Buckets, name)
+	}
+
+	for _, name := range updated {
+		bucket, err := r.updateBucket(ctx, plan.OrgID.ValueString(), name, state.Buckets[name].ID.ValueString(), plan.Buckets[name])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Buckets",
+				"Could not update bucket "+name+": "+formatAPIError(err),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+			return
+		}
+		current.Buckets[name] = bucket
+	}
+
+	for _, name := range created {
+		bucket, err := r.createBucket(ctx, plan.OrgID.ValueString(), name, plan.Buckets[name])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Buckets",
+				"Could not create bucket "+name+": "+formatAPIError(err),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+			return
+		}
+		current.Buckets[name] = bucket
+	}
+
+	tflog.Trace(ctx, "Updated buckets", map[string]any{"org_id": plan.OrgID.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &current)...)
+}
+
+func (r *BucketsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, removed := diffBucketsMap(nil, state.Buckets)
+
+	tflog.Debug(ctx, "Deleting buckets", map[string]any{"org_id": state.OrgID.ValueString(), "names": removed})
+
+	for _, name := range removed {
+		err := r.client.BucketsAPI().DeleteBucketWithID(ctx, state.Buckets[name].ID.ValueString())
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Buckets",
+				"Could not delete bucket "+name+": "+formatAPIError(err),
+			)
+			// Keep the buckets left in the state.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		delete(state.Buckets, name)
+	}
+
+	tflog.Trace(ctx, "Deleted buckets", map[string]any{"org_id": state.OrgID.ValueString(), "count": len(removed)})
+}
+
+// Helper function to create a bucket of the map
+func (r *BucketsResource) createBucket(ctx context.Context, orgID, name string, item BucketsResourceItemModel) (BucketsResourceItemModel, error) {
+	desc := item.Description.ValueString()
+
+	var retentionRules domain.RetentionRules
+	if seconds := item.RetentionSeconds.ValueInt64(); seconds > 0 {
+		ruleType := domain.RetentionRuleTypeExpire
+		retentionRules = domain.RetentionRules{{EverySeconds: seconds, Type: &ruleType}}
+	}
+
+	result, err := r.client.BucketsAPI().CreateBucket(ctx, &domain.Bucket{
+		Description:    &desc,
+		Name:           name,
+		OrgID:          &orgID,
+		RetentionRules: retentionRules,
+	})
+	if err != nil {
+		return BucketsResourceItemModel{}, err
+	}
+
+	return bucketsResourceItemFromDomain(result), nil
+}
+
+// Helper function to update the description and retention of a bucket of the map
+func (r *BucketsResource) updateBucket(ctx context.Context, orgID, name, id string, item BucketsResourceItemModel) (BucketsResourceItemModel, error) {
+	desc := item.Description.ValueString()
+
+	// An empty rules list leaves the retention untouched on update, so
+	// infinite retention is sent as an explicit zero duration rule.
+	ruleType := domain.RetentionRuleTypeExpire
+	retentionRules := domain.RetentionRules{{EverySeconds: item.RetentionSeconds.ValueInt64(), Type: &ruleType}}
+
+	result, err := r.client.BucketsAPI().UpdateBucket(ctx, &domain.Bucket{
+		Id:             &id,
+		Description:    &desc,
+		Name:           name,
+		OrgID:          &orgID,
+		RetentionRules: retentionRules,
+	})
+	if err != nil {
+		return BucketsResourceItemModel{}, err
+	}
+
+	return bucketsResourceItemFromDomain(result), nil
+}
+
+// Helper function to convert a domain bucket into the resource item model
+func bucketsResourceItemFromDomain(bucket *domain.Bucket) BucketsResourceItemModel {
+	item := BucketsResourceItemModel{
+		ID:               types.StringValue(""),
+		Description:      types.StringValue(""),
+		RetentionSeconds: types.Int64Value(retentionSecondsFromDomain(bucket.RetentionRules)),
+	}
+
+	if bucket.Id != nil {
+		item.ID = types.StringValue(*bucket.Id)
+	}
+	if bucket.Description != nil {
+		item.Description = types.StringValue(*bucket.Description)
+	}
+
+	return item
+}
+
+// Helper function to compare the planned buckets with the previous ones,
+// returning the sorted names of the buckets to create, update and remove.
+func diffBucketsMap(planned, previous map[string]BucketsResourceItemModel) (created, updated, removed []string) {
+	for name, bucket := range planned {
+		prior, ok := previous[name]
+		if !ok {
+			created = append(created, name)
+			continue
+		}
+		if !bucket.Description.Equal(prior.Description) || !bucket.RetentionSeconds.Equal(prior.RetentionSeconds) {
+			updated = append(updated, name)
+		}
+	}
+	for name := range previous {
+		if _, ok := planned[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(created)
+	sort.Strings(updated)
+	sort.Strings(removed)
+
+	return created, updated, removed
+}
@@ -0,0 +1,90 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBucketsResource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBucketsResourceConfig(orgID, `
+    "test-bulk-a" = { retention_seconds = 3600 }
+    "test-bulk-b" = { description = "Second bucket" }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_buckets.test", "id", orgID),
+					resource.TestCheckResourceAttr("influxdb-v2_buckets.test", "buckets.%", "2"),
+					resource.TestCheckResourceAttrSet("influxdb-v2_buckets.test", "buckets.test-bulk-a.id"),
+					resource.TestCheckResourceAttr("influxdb-v2_buckets.test", "buckets.test-bulk-a.retention_seconds", "3600"),
+					resource.TestCheckResourceAttr("influxdb-v2_buckets.test", "buckets.test-bulk-b.retention_seconds", "0"),
+				),
+			},
+			// Update one bucket, remove one and add one
+			{
+				Config: testAccBucketsResourceConfig(orgID, `
+    "test-bulk-a" = { retention_seconds = 7200 }
+    "test-bulk-c" = {}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_buckets.test", "buckets.%", "2"),
+					resource.TestCheckResourceAttr("influxdb-v2_buckets.test", "buckets.test-bulk-a.retention_seconds", "7200"),
+					resource.TestCheckNoResourceAttr("influxdb-v2_buckets.test", "buckets.test-bulk-b.id"),
+					resource.TestCheckResourceAttrSet("influxdb-v2_buckets.test", "buckets.test-bulk-c.id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDiffBucketsMap(t *testing.T) {
+	item := func(description string, retention int64) BucketsResourceItemModel {
+		return BucketsResourceItemModel{
+			Description:      types.StringValue(description),
+			RetentionSeconds: types.Int64Value(retention),
+		}
+	}
+
+	planned := map[string]BucketsResourceItemModel{
+		"kept":    item("", 3600),
+		"changed": item("new description", 3600),
+		"added":   item("", 0),
+	}
+	previous := map[string]BucketsResourceItemModel{
+		"kept":    item("", 3600),
+		"changed": item("", 3600),
+		"removed": item("", 0),
+	}
+
+	created, updated, removed := diffBucketsMap(planned, previous)
+	if !reflect.DeepEqual(created, []string{"added"}) {
+		t.Errorf("unexpected created buckets: %v", created)
+	}
+	if !reflect.DeepEqual(updated, []string{"changed"}) {
+		t.Errorf("unexpected updated buckets: %v", updated)
+	}
+	if !reflect.DeepEqual(removed, []string{"removed"}) {
+		t.Errorf("unexpected removed buckets: %v", removed)
+	}
+}
+
+func testAccBucketsResourceConfig(orgID, buckets string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_buckets" "test" {
+  org_id  = %[1]q
+  buckets = {%[2]s}
+}
+`, orgID, buckets)
+}
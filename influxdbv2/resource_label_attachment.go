@@ -0,0 +1,274 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// labelAttachmentResourceTypes lists the object types whose labels are
+// managed through the /{type}/{id}/labels endpoints.
+var labelAttachmentResourceTypes = []string{
+	"buckets",
+	"checks",
+	"dashboards",
+	"notificationEndpoints",
+	"notificationRules",
+	"tasks",
+	"telegrafs",
+	"variables",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LabelAttachmentResource{}
+var _ resource.ResourceWithImportState = &LabelAttachmentResource{}
+var _ resource.ResourceWithValidateConfig = &LabelAttachmentResource{}
+
+func NewLabelAttachmentResource() resource.Resource {
+	return &LabelAttachmentResource{}
+}
+
+// LabelAttachmentResource defines the resource implementation.
+type LabelAttachmentResource struct {
+	client influxdb2.Client
+}
+
+// LabelAttachmentResourceModel describes the resource data model.
+type LabelAttachmentResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	LabelID      types.String `tfsdk:"label_id"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
+
+// labelMappingLabel is a label attached to an object, as listed by the API.
+type labelMappingLabel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (r *LabelAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label_attachment"
+}
+
+func (r *LabelAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a label to an InfluxDB v2 object of any type supporting labels.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the attachment, in the form <resource_type>/<resource_id>/<label_id>.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_id": schema.StringAttribute{
+				Description: "The ID of the label to attach.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "The ID of the object to attach the label to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "The type of the object, one of '" + strings.Join(labelAttachmentResourceTypes, "', '") + "'.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LabelAttachmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config LabelAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ResourceType.IsNull() && !config.ResourceType.IsUnknown() && !isLabelAttachmentResourceType(config.ResourceType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("resource_type"),
+			"Invalid Resource Type",
+			"The resource_type must be one of '"+strings.Join(labelAttachmentResourceTypes, "', '")+"', got: "+config.ResourceType.ValueString(),
+		)
+	}
+}
+
+func (r *LabelAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *LabelAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LabelAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Attaching label", map[string]any{
+		"label_id":      plan.LabelID.ValueString(),
+		"resource_id":   plan.ResourceID.ValueString(),
+		"resource_type": plan.ResourceType.ValueString(),
+	})
+
+	body := map[string]string{"labelID": plan.LabelID.ValueString()}
+	err := doAPIRequest(ctx, r.client, http.MethodPost, plan.labelsPath(), body, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Attaching Label",
+			"Could not attach label ID "+plan.LabelID.ValueString()+" to "+plan.ResourceType.ValueString()+" ID "+
+				plan.ResourceID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ResourceType.ValueString() + "/" + plan.ResourceID.ValueString() + "/" + plan.LabelID.ValueString())
+
+	tflog.Trace(ctx, "Attached label", map[string]any{"id": plan.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LabelAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LabelAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result struct {
+		Labels []labelMappingLabel `json:"labels"`
+	}
+	err := doAPIRequest(ctx, r.client, http.MethodGet, state.labelsPath(), nil, &result)
+	if isNotFoundError(err) {
+		tflog.Debug(ctx, "Labeled object no longer exists", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Label Attachment",
+			"Could not read labels of "+state.ResourceType.ValueString()+" ID "+state.ResourceID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	for _, label := range result.Labels {
+		if label.ID == state.LabelID.ValueString() {
+			// Save updated data into Terraform state
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Label no longer attached", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *LabelAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, so there is nothing to update.
+	var plan LabelAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LabelAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LabelAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Detaching label", map[string]any{"id": state.ID.ValueString()})
+
+	err := doAPIRequest(ctx, r.client, http.MethodDelete, state.labelsPath()+"/"+state.LabelID.ValueString(), nil, nil)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Detaching Label",
+			"Could not detach label: "+formatAPIError(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Detached label", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *LabelAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" || !isLabelAttachmentResourceType(parts[0]) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import ID in the form <resource_type>/<resource_id>/<label_id>, with a resource type among '"+
+				strings.Join(labelAttachmentResourceTypes, "', '")+"', got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("label_id"), parts[2])...)
+}
+
+// Helper function to build the path of the labels endpoint of the labeled object
+func (m *LabelAttachmentResourceModel) labelsPath() string {
+	return m.ResourceType.ValueString() + "/" + m.ResourceID.ValueString() + "/labels"
+}
+
+// Helper function reporting whether labels can be attached to the object type
+func isLabelAttachmentResourceType(resourceType string) bool {
+	for _, supported := range labelAttachmentResourceTypes {
+		if resourceType == supported {
+			return true
+		}
+	}
+
+	return false
+}
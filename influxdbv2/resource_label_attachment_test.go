@@ -0,0 +1,178 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLabelAttachmentResource_InvalidResourceType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLabelAttachmentResourceConfig("0000000000000001", "organizations", "0000000000000002"),
+				ExpectError: regexp.MustCompile("Invalid Resource Type"),
+			},
+		},
+	})
+}
+
+func TestAccLabelAttachmentResource_MissingLabel(t *testing.T) {
+	bucketID := os.Getenv("INFLUXDB_V2_BUCKET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLabelAttachmentResourceConfig("0000000000000001", "buckets", bucketID),
+				ExpectError: regexp.MustCompile("Error Attaching Label"),
+			},
+		},
+	})
+}
+
+func TestAccLabelAttachmentResource_Variable(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	// The fixtures are created before the test case, whose configurations
+	// embed their IDs.
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skip("Acceptance tests skipped unless env '" + resource.EnvTfAcc + "' set")
+	}
+	testAccPreCheck(t)
+	labelID := testAccCreateLabel(t, orgID, "tf-acc-variable-label")
+	otherLabelID := testAccCreateLabel(t, orgID, "tf-acc-variable-external-label")
+	variableID := testAccCreateVariable(t, orgID, "tf_acc_labeled_variable")
+	// A label attached outside of Terraform must be left alone
+	testAccAttachLabel(t, "variables", variableID, otherLabelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLabelAttachmentResourceConfig(labelID, "variables", variableID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_label_attachment.test", "id", "variables/"+variableID+"/"+labelID),
+					testAccCheckLabelsAttached(t, "variables", &variableID, &labelID, &otherLabelID),
+				),
+			},
+			{
+				ResourceName:      "influxdb-v2_label_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The variable data source lists both labels
+			{
+				Config: testAccLabelAttachmentResourceConfig(labelID, "variables", variableID) +
+					testAccVariableDataSourceConfig(orgID, "tf_acc_labeled_variable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb-v2_variable.test", "labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.influxdb-v2_variable.test", "labels.*", labelID),
+					resource.TestCheckTypeSetElemAttr("data.influxdb-v2_variable.test", "labels.*", otherLabelID),
+				),
+			},
+			// Detaching keeps the external label
+			{
+				Config: `# no attachment`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLabelsAttached(t, "variables", &variableID, &otherLabelID),
+				),
+			},
+		},
+	})
+}
+
+// Helper function to create a label removed at the end of the test
+func testAccCreateLabel(t *testing.T, orgID, name string) string {
+	client := testAccClient(t)
+
+	var result struct {
+		Label labelMappingLabel `json:"label"`
+	}
+	body := map[string]string{"orgID": orgID, "name": name}
+	if err := doAPIRequest(context.Background(), client, http.MethodPost, "labels", body, &result); err != nil {
+		t.Fatalf("could not create label %s: %s", name, err)
+	}
+	t.Cleanup(func() {
+		_ = doAPIRequest(context.Background(), client, http.MethodDelete, "labels/"+result.Label.ID, nil, nil)
+	})
+
+	return result.Label.ID
+}
+
+// Helper function to create a constant variable removed at the end of the test
+func testAccCreateVariable(t *testing.T, orgID, name string) string {
+	client := testAccClient(t)
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	body := map[string]any{
+		"orgID":     orgID,
+		"name":      name,
+		"arguments": map[string]any{"type": "constant", "values": []string{"a", "b"}},
+	}
+	if err := doAPIRequest(context.Background(), client, http.MethodPost, "variables", body, &result); err != nil {
+		t.Fatalf("could not create variable %s: %s", name, err)
+	}
+	t.Cleanup(func() {
+		_ = doAPIRequest(context.Background(), client, http.MethodDelete, "variables/"+result.ID, nil, nil)
+	})
+
+	return result.ID
+}
+
+// Helper function to attach a label outside of Terraform
+func testAccAttachLabel(t *testing.T, resourceType, resourceID, labelID string) {
+	body := map[string]string{"labelID": labelID}
+	if err := doAPIRequest(context.Background(), testAccClient(t), http.MethodPost, resourceType+"/"+resourceID+"/labels", body, nil); err != nil {
+		t.Fatalf("could not attach label %s: %s", labelID, err)
+	}
+}
+
+// Helper function to check the exact labels attached to an object
+func testAccCheckLabelsAttached(t *testing.T, resourceType string, resourceID *string, labelIDs ...*string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var result struct {
+			Labels []labelMappingLabel `json:"labels"`
+		}
+		if err := doAPIRequest(context.Background(), testAccClient(t), http.MethodGet, resourceType+"/"+*resourceID+"/labels", nil, &result); err != nil {
+			return err
+		}
+
+		attached := map[string]bool{}
+		for _, label := range result.Labels {
+			attached[label.ID] = true
+		}
+		if len(attached) != len(labelIDs) {
+			return fmt.Errorf("expected %d labels attached, got %d", len(labelIDs), len(attached))
+		}
+		for _, labelID := range labelIDs {
+			if !attached[*labelID] {
+				return fmt.Errorf("label %s is not attached", *labelID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccLabelAttachmentResourceConfig(labelID, resourceType, resourceID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_label_attachment" "test" {
+  label_id      = %[1]q
+  resource_type = %[2]q
+  resource_id   = %[3]q
+}
+`, labelID, resourceType, resourceID)
+}
@@ -0,0 +1,203 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationOwnerResource{}
+var _ resource.ResourceWithImportState = &OrganizationOwnerResource{}
+
+func NewOrganizationOwnerResource() resource.Resource {
+	return &OrganizationOwnerResource{}
+}
+
+// OrganizationOwnerResource defines the resource implementation.
+type OrganizationOwnerResource struct {
+	client influxdb2.Client
+}
+
+// OrganizationOwnerResourceModel describes the resource data model.
+type OrganizationOwnerResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	OrgID  types.String `tfsdk:"org_id"`
+	UserID types.String `tfsdk:"user_id"`
+}
+
+func (r *OrganizationOwnerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_owner"
+}
+
+func (r *OrganizationOwnerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Makes a user an owner of an InfluxDB v2 organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the ownership, in the form <org_id>/<user_id>.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The ID of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user owning the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationOwnerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *OrganizationOwnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationOwnerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Adding organization owner", map[string]any{"org_id": plan.OrgID.ValueString(), "user_id": plan.UserID.ValueString()})
+
+	_, err := r.client.OrganizationsAPI().AddOwnerWithID(ctx, plan.OrgID.ValueString(), plan.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding Organization Owner",
+			"Could not add user ID "+plan.UserID.ValueString()+" as owner of organization ID "+plan.OrgID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.OrgID.ValueString() + "/" + plan.UserID.ValueString())
+
+	tflog.Trace(ctx, "Added organization owner", map[string]any{"id": plan.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationOwnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationOwnerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owners, err := r.client.OrganizationsAPI().GetOwnersWithID(ctx, state.OrgID.ValueString())
+	if isNotFoundError(err) {
+		tflog.Debug(ctx, "Organization no longer exists", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization Owners",
+			"Could not read owners of organization ID "+state.OrgID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	if owners != nil {
+		for _, owner := range *owners {
+			if owner.Id != nil && *owner.Id == state.UserID.ValueString() {
+				// Save updated data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+				return
+			}
+		}
+	}
+
+	tflog.Debug(ctx, "User is no longer an organization owner", map[string]any{"id": state.ID.ValueString()})
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OrganizationOwnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, so there is nothing to update.
+	var plan OrganizationOwnerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationOwnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationOwnerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing organization owner", map[string]any{"id": state.ID.ValueString()})
+
+	err := r.client.OrganizationsAPI().RemoveOwnerWithID(ctx, state.OrgID.ValueString(), state.UserID.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Removing Organization Owner",
+			"Could not remove organization owner: "+formatAPIError(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Removed organization owner", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *OrganizationOwnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an import ID in the form <org_id>/<user_id>, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
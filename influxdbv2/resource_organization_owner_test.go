@@ -0,0 +1,63 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationOwnerResource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+	userID := os.Getenv("INFLUXDB_V2_USER_ID")
+	if userID == "" {
+		t.Skip("INFLUXDB_V2_USER_ID must be set to test organization owners")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationOwnerResourceConfig(orgID, userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_organization_owner.test", "id", orgID+"/"+userID),
+				),
+			},
+			{
+				ResourceName:      "influxdb-v2_organization_owner.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOrganizationOwnerResource_InvalidImportID(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccOrganizationOwnerResourceConfig(orgID, "0000000000000001"),
+				ResourceName:  "influxdb-v2_organization_owner.test",
+				ImportState:   true,
+				ImportStateId: orgID,
+				ExpectError:   regexp.MustCompile("Invalid Import ID"),
+			},
+		},
+	})
+}
+
+func testAccOrganizationOwnerResourceConfig(orgID, userID string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_organization_owner" "test" {
+  org_id  = %[1]q
+  user_id = %[2]q
+}
+`, orgID, userID)
+}
@@ -0,0 +1,197 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// readyGatePollInterval is the delay between two readiness checks.
+var readyGatePollInterval = time.Second
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ReadyGateResource{}
+var _ resource.ResourceWithValidateConfig = &ReadyGateResource{}
+
+func NewReadyGateResource() resource.Resource {
+	return &ReadyGateResource{}
+}
+
+// ReadyGateResource defines the resource implementation.
+type ReadyGateResource struct {
+	client influxdb2.Client
+}
+
+// ReadyGateResourceModel describes the resource data model.
+type ReadyGateResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+	Status         types.String `tfsdk:"status"`
+	Started        types.String `tfsdk:"started"`
+}
+
+func (r *ReadyGateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ready_gate"
+}
+
+func (r *ReadyGateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits for the InfluxDB server to be ready when created, so that other resources can depend on it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource identifier (server URL).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "How long to wait for the server to be ready, in seconds. Defaults to 60.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60),
+			},
+			"status": schema.StringAttribute{
+				Description: "The server status when it became ready.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started": schema.StringAttribute{
+				Description: "Timestamp when the server started.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReadyGateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *ReadyGateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ReadyGateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TimeoutSeconds.IsNull() && !config.TimeoutSeconds.IsUnknown() && config.TimeoutSeconds.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout_seconds"),
+			"Invalid Timeout",
+			"timeout_seconds must be at least 1.",
+		)
+	}
+}
+
+func (r *ReadyGateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReadyGateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := time.Duration(plan.TimeoutSeconds.ValueInt64()) * time.Second
+
+	tflog.Debug(ctx, "Waiting for InfluxDB server to be ready", map[string]any{"timeout": timeout.String()})
+
+	ready, err := waitForReady(ctx, r.client, timeout, readyGatePollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Server Not Ready",
+			fmt.Sprintf("InfluxDB server was not ready within %s: %s", timeout, formatAPIError(err)),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(r.client.ServerURL())
+	plan.Status = types.StringValue("unknown")
+	if ready.Status != nil {
+		plan.Status = types.StringValue(string(*ready.Status))
+	}
+	plan.Started = types.StringValue("")
+	if ready.Started != nil {
+		plan.Started = types.StringValue(ready.Started.String())
+	}
+
+	tflog.Trace(ctx, "InfluxDB server is ready", map[string]any{"url": plan.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReadyGateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The gate only waits when created, later plans keep it as is
+}
+
+func (r *ReadyGateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ReadyGateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeout can change, which has no effect once the gate is open
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReadyGateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Nothing to delete on the server
+}
+
+// Helper function to poll the server until it is ready or the timeout expires
+func waitForReady(ctx context.Context, client influxdb2.Client, timeout, interval time.Duration) (*domain.Ready, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		ready, err := client.Ready(ctx)
+		if err == nil {
+			return ready, nil
+		}
+
+		tflog.Debug(ctx, "InfluxDB server not ready yet", map[string]any{"error": err.Error()})
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(interval):
+		}
+	}
+}
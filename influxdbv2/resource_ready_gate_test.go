@@ -0,0 +1,108 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestWaitForReady(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Not ready for the first two checks
+		if calls.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "ready", "started": "2024-01-01T00:00:00Z", "up": "1s"}`))
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "my-token")
+	defer client.Close()
+
+	ready, err := waitForReady(context.Background(), client, 5*time.Second, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ready.Status == nil || *ready.Status != "ready" {
+		t.Errorf("unexpected status: %v", ready.Status)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 readiness checks, got %d", calls.Load())
+	}
+}
+
+func TestWaitForReady_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "my-token")
+	defer client.Close()
+
+	if _, err := waitForReady(context.Background(), client, 100*time.Millisecond, 10*time.Millisecond); err == nil {
+		t.Fatal("expected an error when the server never becomes ready")
+	}
+}
+
+func TestAccReadyGateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReadyGateResourceConfig(30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb-v2_ready_gate.test", "id"),
+					resource.TestCheckResourceAttr("influxdb-v2_ready_gate.test", "timeout_seconds", "30"),
+					resource.TestCheckResourceAttr("influxdb-v2_ready_gate.test", "status", "ready"),
+					resource.TestCheckResourceAttrSet("influxdb-v2_ready_gate.test", "started"),
+					resource.TestCheckResourceAttrPair("influxdb-v2_ready_gate.test", "id", "data.influxdb-v2_ready.after_gate", "url"),
+				),
+			},
+			// Changing the timeout updates in place
+			{
+				Config: testAccReadyGateResourceConfig(60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_ready_gate.test", "timeout_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReadyGateResource_InvalidTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReadyGateResourceConfig(0),
+				ExpectError: regexp.MustCompile(`Invalid Timeout`),
+			},
+		},
+	})
+}
+
+func testAccReadyGateResourceConfig(timeout int) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_ready_gate" "test" {
+  timeout_seconds = %d
+}
+
+data "influxdb-v2_ready" "after_gate" {
+  depends_on = [influxdb-v2_ready_gate.test]
+}
+`, timeout)
+}
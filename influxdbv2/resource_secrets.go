@@ -0,0 +1,305 @@
+package influxdbv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecretsResource{}
+var _ resource.ResourceWithValidateConfig = &SecretsResource{}
+
+func NewSecretsResource() resource.Resource {
+	return &SecretsResource{}
+}
+
+// SecretsResource defines the resource implementation.
+type SecretsResource struct {
+	client influxdb2.Client
+}
+
+// SecretsResourceModel describes the resource data model.
+type SecretsResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	OrgID   types.String `tfsdk:"org_id"`
+	Secrets types.Map    `tfsdk:"secrets"`
+}
+
+func (r *SecretsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (r *SecretsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of InfluxDB v2 organization secrets, written together in a single request.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource (the organization ID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID owning the secrets.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secrets": schema.MapAttribute{
+				Description: "The secret values, by key. Only the keys managed by this resource are touched.",
+				Required:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *SecretsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SecretsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Secrets.IsNull() && !data.Secrets.IsUnknown() && len(data.Secrets.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secrets"),
+			"Invalid Secrets",
+			"At least one secret must be set.",
+		)
+	}
+}
+
+func (r *SecretsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *SecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SecretsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets := map[string]string{}
+	resp.Diagnostics.Append(plan.Secrets.ElementsAs(ctx, &secrets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating secrets", map[string]any{"org_id": plan.OrgID.ValueString(), "keys": sortedSecretKeys(secrets)})
+
+	if err := r.patchSecrets(ctx, plan.OrgID.ValueString(), secrets); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Secrets",
+			"Could not create secrets: "+formatAPIError(err),
+		)
+		return
+	}
+
+	plan.ID = plan.OrgID
+
+	tflog.Trace(ctx, "Created secrets", map[string]any{"org_id": plan.OrgID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SecretsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets := map[string]string{}
+	resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &secrets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.APIClient().GetOrgsIDSecrets(ctx, &domain.GetOrgsIDSecretsAllParams{
+		OrgID: state.OrgID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Secrets",
+			"Could not read secrets of organization ID "+state.OrgID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	// InfluxDB never returns secret values, so only the presence of each key
+	// can be checked. Keys removed outside of Terraform are dropped from the
+	// state to be written again on the next apply.
+	existing := map[string]bool{}
+	if result.Secrets != nil {
+		for _, key := range *result.Secrets {
+			existing[key] = true
+		}
+	}
+	for key := range secrets {
+		if !existing[key] {
+			tflog.Debug(ctx, "Secret removed outside of Terraform", map[string]any{"org_id": state.OrgID.ValueString(), "key": key})
+			delete(secrets, key)
+		}
+	}
+
+	if len(secrets) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	secretsValue, diags := types.MapValueFrom(ctx, types.StringType, secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Secrets = secretsValue
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SecretsResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := map[string]string{}
+	resp.Diagnostics.Append(plan.Secrets.ElementsAs(ctx, &planned, false)...)
+	previous := map[string]string{}
+	resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removed []string
+	for key := range previous {
+		if _, ok := planned[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	tflog.Debug(ctx, "Updating secrets", map[string]any{"org_id": plan.OrgID.ValueString(), "keys": sortedSecretKeys(planned), "removed": removed})
+
+	if err := r.patchSecrets(ctx, plan.OrgID.ValueString(), planned); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Secrets",
+			"Could not update secrets: "+formatAPIError(err),
+		)
+		return
+	}
+
+	if len(removed) > 0 {
+		if err := r.deleteSecrets(ctx, plan.OrgID.ValueString(), removed); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Secrets",
+				"Could not delete removed secrets: "+formatAPIError(err),
+			)
+			return
+		}
+	}
+
+	plan.ID = plan.OrgID
+
+	tflog.Trace(ctx, "Updated secrets", map[string]any{"org_id": plan.OrgID.ValueString()})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SecretsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets := map[string]string{}
+	resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &secrets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys := sortedSecretKeys(secrets)
+
+	tflog.Debug(ctx, "Deleting secrets", map[string]any{"org_id": state.OrgID.ValueString(), "keys": keys})
+
+	if err := r.deleteSecrets(ctx, state.OrgID.ValueString(), keys); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Secrets",
+			"Could not delete secrets: "+formatAPIError(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted secrets", map[string]any{"org_id": state.OrgID.ValueString()})
+}
+
+// Helper function to write several secrets of an organization in a single request.
+// The generated client serializes the body of PatchOrgsIDSecrets as an empty
+// object, so the request is sent directly.
+func (r *SecretsResource) patchSecrets(ctx context.Context, orgID string, secrets map[string]string) error {
+	return doAPIRequest(ctx, r.client, http.MethodPatch, "orgs/"+orgID+"/secrets", secrets, nil)
+}
+
+// Helper function to delete the given secret keys of an organization.
+func (r *SecretsResource) deleteSecrets(ctx context.Context, orgID string, keys []string) error {
+	return r.client.APIClient().PostOrgsIDSecrets(ctx, &domain.PostOrgsIDSecretsAllParams{
+		OrgID: orgID,
+		Body:  domain.PostOrgsIDSecretsJSONRequestBody{Secrets: &keys},
+	})
+}
+
+// Helper function to list the keys of a secrets map in a stable order.
+func sortedSecretKeys(secrets map[string]string) []string {
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,52 @@
+package influxdbv2
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecretsResource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSecretsResourceConfig(orgID, `
+    tf_acc_user     = "admin"
+    tf_acc_password = "secret"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_secrets.test", "id", orgID),
+					resource.TestCheckResourceAttr("influxdb-v2_secrets.test", "secrets.%", "2"),
+					resource.TestCheckResourceAttr("influxdb-v2_secrets.test", "secrets.tf_acc_user", "admin"),
+				),
+			},
+			// Update removing a key and changing a value
+			{
+				Config: testAccSecretsResourceConfig(orgID, `
+    tf_acc_password = "rotated"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_secrets.test", "secrets.%", "1"),
+					resource.TestCheckResourceAttr("influxdb-v2_secrets.test", "secrets.tf_acc_password", "rotated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSecretsResourceConfig(orgID, secrets string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_secrets" "test" {
+  org_id  = %[1]q
+  secrets = {%[2]s}
+}
+`, orgID, secrets)
+}
@@ -0,0 +1,581 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StackResource{}
+var _ resource.ResourceWithImportState = &StackResource{}
+var _ resource.ResourceWithValidateConfig = &StackResource{}
+
+func NewStackResource() resource.Resource {
+	return &StackResource{}
+}
+
+// StackResource defines the resource implementation.
+type StackResource struct {
+	client influxdb2.Client
+}
+
+// StackResourceModel describes the resource data model.
+type StackResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	OrgID       types.String `tfsdk:"org_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Template    types.String `tfsdk:"template"`
+	TemplateURL types.String `tfsdk:"template_url"`
+	Resources   types.List   `tfsdk:"resources"`
+}
+
+// StackResourceItemModel describes a resource created by the template.
+type StackResourceItemModel struct {
+	Kind       types.String `tfsdk:"kind"`
+	ResourceID types.String `tfsdk:"resource_id"`
+	MetaName   types.String `tfsdk:"meta_name"`
+}
+
+// stackResourceObjectType is the type of a resource created by the template.
+var stackResourceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"kind":        types.StringType,
+		"resource_id": types.StringType,
+		"meta_name":   types.StringType,
+	},
+}
+
+// stackTemplateApply is the body of a template apply request. The client
+// only covers the stacks endpoints, not the apply endpoint.
+type stackTemplateApply struct {
+	OrgID    string                `json:"orgID"`
+	StackID  string                `json:"stackID"`
+	Template *stackTemplate        `json:"template,omitempty"`
+	Remotes  []stackTemplateRemote `json:"remotes,omitempty"`
+}
+
+// stackTemplate is an inline template of a template apply request.
+type stackTemplate struct {
+	ContentType string          `json:"contentType"`
+	Contents    json.RawMessage `json:"contents"`
+}
+
+// stackTemplateRemote is a template fetched by InfluxDB from a URL, whose
+// format is detected from its extension.
+type stackTemplateRemote struct {
+	URL string `json:"url"`
+}
+
+// stackTemplateObject is the part of a template object checked before it is
+// applied.
+type stackTemplateObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+}
+
+func (r *StackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack"
+}
+
+func (r *StackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies an InfluxDB template through a stack, which tracks the resources created by the template.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the stack.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organization ID in which the template resources are created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the stack.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the stack.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"template": schema.StringAttribute{
+				Description: "The template to apply, as YAML or JSON. Conflicts with template_url.",
+				Optional:    true,
+			},
+			"template_url": schema.StringAttribute{
+				Description: "The URL of the template to apply, fetched by InfluxDB. Conflicts with template.",
+				Optional:    true,
+			},
+			"resources": schema.ListNestedAttribute{
+				Description: "The resources created by the template.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							Description: "The kind of the resource, such as 'Bucket' or 'Dashboard'.",
+							Computed:    true,
+						},
+						"resource_id": schema.StringAttribute{
+							Description: "The ID of the resource.",
+							Computed:    true,
+						},
+						"meta_name": schema.StringAttribute{
+							Description: "The metadata name of the resource in the template.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *StackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config StackResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !config.Template.IsNull() && !config.TemplateURL.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template"),
+			"Conflicting Stack Template",
+			"Only one of template or template_url can be set.",
+		)
+		return
+	case config.Template.IsNull() && config.TemplateURL.IsNull():
+		resp.Diagnostics.AddError(
+			"Missing Stack Template",
+			"One of template or template_url must be set.",
+		)
+		return
+	}
+
+	if !config.Template.IsNull() && !config.Template.IsUnknown() {
+		if _, err := parseStackTemplate(config.Template.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("template"),
+				"Invalid Stack Template",
+				"Could not parse the template: "+err.Error(),
+			)
+		}
+	}
+
+	if !config.TemplateURL.IsNull() && !config.TemplateURL.IsUnknown() {
+		parsed, err := url.Parse(config.TemplateURL.ValueString())
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("template_url"),
+				"Invalid Stack Template URL",
+				"The template_url must be an http or https URL, got: "+config.TemplateURL.ValueString(),
+			)
+		}
+	}
+}
+
+func (r *StackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*influxdbProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *influxdbProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+}
+
+func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StackResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := plan.OrgID.ValueString()
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+
+	tflog.Debug(ctx, "Creating stack", map[string]any{"org_id": orgID, "name": name})
+
+	stack, err := r.client.APIClient().CreateStack(ctx, &domain.CreateStackAllParams{
+		Body: domain.CreateStackJSONRequestBody{
+			OrgID:       &orgID,
+			Name:        &name,
+			Description: &description,
+			Urls:        plan.templateURLs(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Stack",
+			"Could not create stack: "+formatAPIError(err),
+		)
+		return
+	}
+	if stack.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Stack",
+			"InfluxDB returned a stack without ID.",
+		)
+		return
+	}
+	plan.ID = types.StringValue(*stack.Id)
+
+	if err := r.applyTemplate(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Applying Stack Template",
+			"Could not apply the template to stack ID "+plan.ID.ValueString()+": "+formatAPIError(err),
+		)
+
+		// The template is applied atomically, the empty stack is removed.
+		if err := r.client.APIClient().DeleteStack(ctx, &domain.DeleteStackAllParams{
+			DeleteStackParams: domain.DeleteStackParams{OrgID: orgID},
+			StackId:           plan.ID.ValueString(),
+		}); err != nil {
+			tflog.Warn(ctx, "Could not delete the stack of a failed template", map[string]any{"id": plan.ID.ValueString(), "error": formatAPIError(err)})
+		}
+		return
+	}
+
+	found, err := r.readStack(ctx, &plan)
+	resp.Diagnostics.Append(r.stackReadDiagnostics(found, err, "Error Reading Stack After Creation", plan.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Created stack", map[string]any{"id": plan.ID.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StackResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.readStack(ctx, &state)
+	if err == nil && !found {
+		tflog.Debug(ctx, "Stack no longer exists", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(r.stackReadDiagnostics(found, err, "Error Reading Stack", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StackResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	urls := plan.templateURLs()
+	if urls == nil {
+		urls = &[]string{}
+	}
+
+	tflog.Debug(ctx, "Updating stack", map[string]any{"id": plan.ID.ValueString()})
+
+	_, err := r.client.APIClient().UpdateStack(ctx, &domain.UpdateStackAllParams{
+		StackId: plan.ID.ValueString(),
+		Body: domain.UpdateStackJSONRequestBody{
+			Name:         &name,
+			Description:  &description,
+			TemplateURLs: urls,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Stack",
+			"Could not update stack ID "+plan.ID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	// Applying the template to the stack also removes the resources the
+	// template no longer defines.
+	if err := r.applyTemplate(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Applying Stack Template",
+			"Could not apply the template to stack ID "+plan.ID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	found, err := r.readStack(ctx, &plan)
+	resp.Diagnostics.Append(r.stackReadDiagnostics(found, err, "Error Reading Stack After Update", plan.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StackResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting stack", map[string]any{"id": state.ID.ValueString()})
+
+	// Deleting a stack also deletes the resources created by its template.
+	err := r.client.APIClient().DeleteStack(ctx, &domain.DeleteStackAllParams{
+		DeleteStackParams: domain.DeleteStackParams{OrgID: state.OrgID.ValueString()},
+		StackId:           state.ID.ValueString(),
+	})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Stack",
+			"Could not delete stack ID "+state.ID.ValueString()+": "+formatAPIError(err),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted stack", map[string]any{"id": state.ID.ValueString()})
+}
+
+func (r *StackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Helper function to apply the configured template to the stack
+func (r *StackResource) applyTemplate(ctx context.Context, model *StackResourceModel) error {
+	body := stackTemplateApply{
+		OrgID:   model.OrgID.ValueString(),
+		StackID: model.ID.ValueString(),
+	}
+
+	if !model.Template.IsNull() {
+		contents, err := parseStackTemplate(model.Template.ValueString())
+		if err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+		body.Template = &stackTemplate{ContentType: "json", Contents: contents}
+	} else {
+		body.Remotes = []stackTemplateRemote{{URL: model.TemplateURL.ValueString()}}
+	}
+
+	tflog.Debug(ctx, "Applying stack template", map[string]any{"id": model.ID.ValueString()})
+
+	return doAPIRequest(ctx, r.client, http.MethodPost, "templates/apply", body, nil)
+}
+
+// Helper function to read the stack and populate the model. It reports
+// whether the stack was found.
+func (r *StackResource) readStack(ctx context.Context, model *StackResourceModel) (bool, error) {
+	stack, err := r.client.APIClient().ReadStack(ctx, &domain.ReadStackAllParams{StackId: model.ID.ValueString()})
+	if isNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	if stack.OrgID != nil {
+		model.OrgID = types.StringValue(*stack.OrgID)
+	}
+
+	// The latest event holds the current state of the stack.
+	resources := []StackResourceItemModel{}
+	if stack.Events != nil && len(*stack.Events) > 0 {
+		events := *stack.Events
+		event := events[len(events)-1]
+
+		model.Name = types.StringValue(value(event.Name))
+		model.Description = types.StringValue(value(event.Description))
+
+		// Templates are not stored, only the URLs they were fetched from.
+		if model.Template.IsNull() && event.Urls != nil && len(*event.Urls) == 1 {
+			model.TemplateURL = types.StringValue((*event.Urls)[0])
+		}
+
+		if event.Resources != nil {
+			for _, res := range *event.Resources {
+				kind := ""
+				if res.Kind != nil {
+					kind = string(*res.Kind)
+				}
+				resources = append(resources, StackResourceItemModel{
+					Kind:       types.StringValue(kind),
+					ResourceID: types.StringValue(value(res.ResourceID)),
+					MetaName:   types.StringValue(value(res.TemplateMetaName)),
+				})
+			}
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, stackResourceObjectType, resources)
+	if diags.HasError() {
+		return false, fmt.Errorf("could not convert stack resources: %v", diags)
+	}
+	model.Resources = list
+
+	return true, nil
+}
+
+// Helper function to report a failed or empty stack read
+func (r *StackResource) stackReadDiagnostics(found bool, err error, summary, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch {
+	case err != nil:
+		diags.AddError(summary, "Could not read stack ID "+id+": "+formatAPIError(err))
+	case !found:
+		diags.AddError(summary, "Stack ID "+id+" could not be found.")
+	}
+
+	return diags
+}
+
+// Helper function to list the template URL registered with the stack, nil
+// for an inline template
+func (m *StackResourceModel) templateURLs() *[]string {
+	if m.TemplateURL.IsNull() {
+		return nil
+	}
+
+	return &[]string{m.TemplateURL.ValueString()}
+}
+
+// Helper function to check a YAML or JSON template and return it as a JSON
+// array of objects, as expected by InfluxDB. A template may be a single
+// object, and a YAML template may hold several documents.
+func parseStackTemplate(template string) (json.RawMessage, error) {
+	content := bytes.TrimSpace([]byte(template))
+	if !json.Valid(content) {
+		converted, err := yamlStackTemplate(content)
+		if err != nil {
+			return nil, err
+		}
+		content = converted
+	}
+	if len(content) > 0 && content[0] == '{' {
+		content = append(append([]byte{'['}, content...), ']')
+	}
+
+	var objects []stackTemplateObject
+	if err := json.Unmarshal(content, &objects); err != nil {
+		return nil, fmt.Errorf("expected an object or array of objects: %w", err)
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("the template defines no objects")
+	}
+
+	for i, object := range objects {
+		switch {
+		case object.APIVersion == "":
+			return nil, fmt.Errorf("object %d has no apiVersion", i)
+		case object.Kind == "":
+			return nil, fmt.Errorf("object %d has no kind", i)
+		case object.Metadata.Name == "":
+			return nil, fmt.Errorf("object %d of kind %s has no metadata.name", i, object.Kind)
+		}
+	}
+
+	return content, nil
+}
+
+// Helper function to convert the documents of a YAML template to a JSON array
+// of objects
+func yamlStackTemplate(content []byte) ([]byte, error) {
+	objects := []any{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("expected a YAML or JSON template: %w", err)
+		}
+
+		switch document := document.(type) {
+		case nil:
+			// Empty documents, such as after a trailing separator
+		case []any:
+			objects = append(objects, document...)
+		default:
+			objects = append(objects, document)
+		}
+	}
+
+	converted, err := json.Marshal(objects)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert the YAML template to JSON: %w", err)
+	}
+
+	return converted, nil
+}
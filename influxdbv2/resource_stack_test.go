@@ -0,0 +1,288 @@
+package influxdbv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestAccStackResource(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackResourceConfig(orgID, "tf-acc-stack", "tf-acc-stack-bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb-v2_stack.test", "id"),
+					resource.TestCheckResourceAttr("influxdb-v2_stack.test", "name", "tf-acc-stack"),
+					resource.TestCheckResourceAttr("influxdb-v2_stack.test", "resources.#", "1"),
+					resource.TestCheckResourceAttr("influxdb-v2_stack.test", "resources.0.kind", "Bucket"),
+					resource.TestCheckResourceAttr("influxdb-v2_stack.test", "resources.0.meta_name", "tf-acc-stack-bucket"),
+					resource.TestCheckResourceAttrSet("influxdb-v2_stack.test", "resources.0.resource_id"),
+				),
+			},
+			// Resources are added and removed by applying the template again
+			{
+				Config: testAccStackResourceConfig(orgID, "tf-acc-stack-renamed", "tf-acc-stack-other-bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb-v2_stack.test", "name", "tf-acc-stack-renamed"),
+					resource.TestCheckResourceAttr("influxdb-v2_stack.test", "resources.#", "1"),
+					resource.TestCheckResourceAttr("influxdb-v2_stack.test", "resources.0.meta_name", "tf-acc-stack-other-bucket"),
+				),
+			},
+			{
+				ResourceName:            "influxdb-v2_stack.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"template"},
+			},
+		},
+	})
+}
+
+func TestAccStackResource_InvalidTemplate(t *testing.T) {
+	orgID := os.Getenv("INFLUXDB_V2_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_stack" "test" {
+  org_id   = %[1]q
+  template = jsonencode([{ kind = "Bucket", metadata = { name = "missing-api-version" } }])
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Invalid Stack Template`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_stack" "test" {
+  org_id       = %[1]q
+  template_url = "ftp://example.com/template.yml"
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Invalid Stack Template URL`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "influxdb-v2_stack" "test" {
+  org_id = %[1]q
+}
+`, orgID),
+				ExpectError: regexp.MustCompile(`Missing Stack Template`),
+			},
+		},
+	})
+}
+
+func TestParseStackTemplate(t *testing.T) {
+	tests := map[string]struct {
+		template  string
+		expected  string
+		expectErr bool
+	}{
+		"array": {
+			template: `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{"name":"bucket"}}]`,
+			expected: `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{"name":"bucket"}}]`,
+		},
+		"single object": {
+			template: ` {"apiVersion":"influxdata.com/v2alpha1","kind":"Label","metadata":{"name":"label"}}`,
+			expected: `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Label","metadata":{"name":"label"}}]`,
+		},
+		"yaml": {
+			template: "apiVersion: influxdata.com/v2alpha1\nkind: Bucket\nmetadata:\n  name: bucket\n",
+			expected: `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{"name":"bucket"}}]`,
+		},
+		"yaml documents": {
+			template: "---\napiVersion: influxdata.com/v2alpha1\nkind: Bucket\nmetadata:\n  name: bucket\n" +
+				"---\napiVersion: influxdata.com/v2alpha1\nkind: Label\nmetadata:\n  name: label\n---\n",
+			expected: `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{"name":"bucket"}},` +
+				`{"apiVersion":"influxdata.com/v2alpha1","kind":"Label","metadata":{"name":"label"}}]`,
+		},
+		"yaml missing name": {
+			template:  "apiVersion: influxdata.com/v2alpha1\nkind: Bucket\n",
+			expectErr: true,
+		},
+		"invalid": {
+			template:  "kind: [Bucket",
+			expectErr: true,
+		},
+		"empty": {
+			template:  `[]`,
+			expectErr: true,
+		},
+		"missing kind": {
+			template:  `[{"apiVersion":"influxdata.com/v2alpha1","metadata":{"name":"bucket"}}]`,
+			expectErr: true,
+		},
+		"missing name": {
+			template:  `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{}}]`,
+			expectErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			contents, err := parseStackTemplate(test.template)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error %t, got: %v", test.expectErr, err)
+			}
+			if !test.expectErr && string(contents) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, contents)
+			}
+		})
+	}
+}
+
+func TestApplyStackTemplate(t *testing.T) {
+	tests := map[string]struct {
+		model    StackResourceModel
+		template string
+		remotes  []stackTemplateRemote
+	}{
+		"inline": {
+			model: StackResourceModel{
+				Template:    types.StringValue("apiVersion: influxdata.com/v2alpha1\nkind: Bucket\nmetadata:\n  name: bucket\n"),
+				TemplateURL: types.StringNull(),
+			},
+			template: `[{"apiVersion":"influxdata.com/v2alpha1","kind":"Bucket","metadata":{"name":"bucket"}}]`,
+		},
+		"url": {
+			model: StackResourceModel{
+				Template:    types.StringNull(),
+				TemplateURL: types.StringValue("https://example.com/template.yml"),
+			},
+			remotes: []stackTemplateRemote{{URL: "https://example.com/template.yml"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var body stackTemplateApply
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/v2/templates/apply" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("could not decode the request body: %s", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{}`)
+			}))
+			defer server.Close()
+
+			client := influxdb2.NewClient(server.URL, "token")
+			defer client.Close()
+
+			r := &StackResource{client: client}
+			test.model.ID = types.StringValue("0000000000000001")
+			test.model.OrgID = types.StringValue("94d518926178fea7")
+
+			if err := r.applyTemplate(context.Background(), &test.model); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if body.OrgID != "94d518926178fea7" || body.StackID != "0000000000000001" {
+				t.Errorf("expected the org and stack IDs, got %q and %q", body.OrgID, body.StackID)
+			}
+			switch {
+			case test.template == "" && body.Template != nil:
+				t.Errorf("expected no inline template, got %s", body.Template.Contents)
+			case test.template != "" && (body.Template == nil || body.Template.ContentType != "json"):
+				t.Errorf("expected an inline JSON template, got %+v", body.Template)
+			case test.template != "" && string(body.Template.Contents) != test.template:
+				t.Errorf("expected template %s, got %s", test.template, body.Template.Contents)
+			}
+			if len(body.Remotes) != len(test.remotes) || (len(test.remotes) > 0 && body.Remotes[0] != test.remotes[0]) {
+				t.Errorf("expected remotes %v, got %v", test.remotes, body.Remotes)
+			}
+		})
+	}
+}
+
+func TestReadStack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/stacks/0000000000000001":
+			fmt.Fprint(w, `{"id":"0000000000000001","orgID":"94d518926178fea7","events":[`+
+				`{"eventType":"create","name":"initial","resources":[]},`+
+				`{"eventType":"update","name":"monitoring","description":"Monitoring resources",`+
+				`"urls":["https://example.com/template.yml"],`+
+				`"resources":[{"kind":"Bucket","resourceID":"0000000000000002","templateMetaName":"bucket"}]}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"code":"not found","message":"stack not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	r := &StackResource{client: client}
+
+	model := StackResourceModel{
+		ID:          types.StringValue("0000000000000001"),
+		Template:    types.StringNull(),
+		TemplateURL: types.StringNull(),
+	}
+	found, err := r.readStack(context.Background(), &model)
+	if err != nil || !found {
+		t.Fatalf("expected the stack to be found, got found %t and error: %v", found, err)
+	}
+
+	if model.OrgID.ValueString() != "94d518926178fea7" {
+		t.Errorf("expected org ID 94d518926178fea7, got %s", model.OrgID)
+	}
+	if model.Name.ValueString() != "monitoring" || model.Description.ValueString() != "Monitoring resources" {
+		t.Errorf("expected the latest event to be read, got name %s and description %s", model.Name, model.Description)
+	}
+	if model.TemplateURL.ValueString() != "https://example.com/template.yml" {
+		t.Errorf("expected the template URL of the latest event, got %s", model.TemplateURL)
+	}
+
+	var resources []StackResourceItemModel
+	model.Resources.ElementsAs(context.Background(), &resources, false)
+	if len(resources) != 1 || resources[0].Kind.ValueString() != "Bucket" ||
+		resources[0].ResourceID.ValueString() != "0000000000000002" || resources[0].MetaName.ValueString() != "bucket" {
+		t.Errorf("expected the bucket of the latest event, got %v", resources)
+	}
+
+	missing := StackResourceModel{ID: types.StringValue("0000000000000009")}
+	found, err = r.readStack(context.Background(), &missing)
+	if err != nil || found {
+		t.Errorf("expected a missing stack to be not found, got found %t and error: %v", found, err)
+	}
+}
+
+func testAccStackResourceConfig(orgID, name, bucket string) string {
+	return fmt.Sprintf(`
+resource "influxdb-v2_stack" "test" {
+  org_id      = %[1]q
+  name        = %[2]q
+  description = "Stack of acceptance tests"
+  template = jsonencode([{
+    apiVersion = "influxdata.com/v2alpha1"
+    kind       = "Bucket"
+    metadata   = { name = %[3]q }
+    spec       = { name = %[3]q }
+  }])
+}
+`, orgID, name, bucket)
+}
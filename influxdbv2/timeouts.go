@@ -0,0 +1,11 @@
+package influxdbv2
+
+import "time"
+
+// Operation timeouts used when the timeouts block does not set them.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
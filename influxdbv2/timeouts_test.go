@@ -0,0 +1,66 @@
+package influxdbv2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestBucketResource_ReadTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := influxdb2.NewClient(server.URL, "token")
+	defer client.Close()
+
+	ctx := context.Background()
+	r := &BucketResource{client: client}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, "0000000000000001")
+
+	timeoutsType := objectType.AttributeTypes["timeouts"].(tftypes.Object)
+	timeoutValues := map[string]tftypes.Value{}
+	for name, attributeType := range timeoutsType.AttributeTypes {
+		timeoutValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	timeoutValues["read"] = tftypes.NewValue(tftypes.String, "10ms")
+	values["timeouts"] = tftypes.NewValue(timeoutsType, timeoutValues)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	resp := fwresource.ReadResponse{State: state}
+
+	start := time.Now()
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the read to time out")
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("expected the read to be cancelled by its timeout, took %s", elapsed)
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "deadline exceeded") {
+		t.Errorf("expected a deadline error, got: %s", detail)
+	}
+}
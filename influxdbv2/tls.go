@@ -0,0 +1,33 @@
+package influxdbv2
+
+import (
+	"crypto/tls"
+	"os"
+	"strings"
+)
+
+// Helper function to load a client certificate for mutual TLS. The
+// certificate and key are each given either as PEM content or as the path of
+// a PEM file.
+func loadClientCertificate(cert, key string) (tls.Certificate, error) {
+	certPEM, err := readPEM(cert)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	keyPEM, err := readPEM(key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// Helper function to return PEM content as is, or read it from the file at
+// the given path.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN ") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
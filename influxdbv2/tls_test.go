@@ -0,0 +1,82 @@
+package influxdbv2
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadClientCertificate(t *testing.T) {
+	certPEM, keyPEM := testClientCertificatePEM(t)
+	_, otherKeyPEM := testClientCertificatePEM(t)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certFile, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		cert  string
+		key   string
+		error bool
+	}{
+		{name: "PEM content", cert: string(certPEM), key: string(keyPEM)},
+		{name: "file paths", cert: certFile, key: keyFile},
+		{name: "PEM certificate and key file", cert: string(certPEM), key: keyFile},
+		{name: "mismatched key", cert: string(certPEM), key: string(otherKeyPEM), error: true},
+		{name: "missing file", cert: filepath.Join(dir, "missing.crt"), key: keyFile, error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			certificate, err := loadClientCertificate(test.cert, test.key)
+			if (err != nil) != test.error {
+				t.Fatalf("expected error %t, got %v", test.error, err)
+			}
+			if !test.error && len(certificate.Certificate) != 1 {
+				t.Errorf("expected 1 certificate, got %d", len(certificate.Certificate))
+			}
+		})
+	}
+}
+
+// Helper function to generate a self-signed client certificate and its key
+func testClientCertificatePEM(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
@@ -0,0 +1,194 @@
+package influxdbv2
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// traceSpanHeader is the header InfluxDB reads OpenTracing span context from.
+const traceSpanHeader = "Zap-Trace-Span"
+
+// Helper function to set the connection limits of the transport built by the
+// InfluxDB client. Unset limits keep the client defaults. All requests go to
+// a single host, so idle connections are limited per host the same way.
+func configureConnectionPool(client *http.Client, maxIdleConns, maxConnsPerHost types.Int64) {
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		return
+	}
+
+	if !maxIdleConns.IsNull() {
+		transport.MaxIdleConns = int(maxIdleConns.ValueInt64())
+		transport.MaxIdleConnsPerHost = int(maxIdleConns.ValueInt64())
+	}
+	if !maxConnsPerHost.IsNull() {
+		transport.MaxConnsPerHost = int(maxConnsPerHost.ValueInt64())
+	}
+}
+
+// traceSpanTransport sets the Zap-Trace-Span header of every request, with the
+// configured trace ID and a new span ID for each request.
+type traceSpanTransport struct {
+	traceID string
+	next    http.RoundTripper
+}
+
+func (t *traceSpanTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	span, err := traceSpanValue(t.traceID, newSpanID())
+	if err != nil {
+		return nil, err
+	}
+
+	// RoundTrippers must not modify the original request.
+	req = req.Clone(req.Context())
+	req.Header.Set(traceSpanHeader, span)
+
+	return t.next.RoundTrip(req)
+}
+
+// Helper function to build the Zap-Trace-Span header value of a span
+func traceSpanValue(traceID, spanID string) (string, error) {
+	span, err := json.Marshal(map[string]any{
+		"trace_id": traceID,
+		"span_id":  spanID,
+		"baggage":  map[string]string{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(span), nil
+}
+
+// Helper function to generate a random 64-bit span ID, in hexadecimal
+func newSpanID() string {
+	var id [8]byte
+	_, _ = rand.Read(id[:])
+
+	return hex.EncodeToString(id[:])
+}
+
+// latencyLogTransport logs the method, path, status and duration of every request.
+type latencyLogTransport struct {
+	next http.RoundTripper
+}
+
+func (t *latencyLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	fields := map[string]any{
+		"method":      req.Method,
+		"path":        req.URL.Path,
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = resp.StatusCode
+	}
+	tflog.Debug(req.Context(), "InfluxDB API request", fields)
+
+	return resp, err
+}
+
+// rateLimitTransport delays requests so that they do not exceed a fixed rate.
+type rateLimitTransport struct {
+	limiter *rate.Limiter
+	next    http.RoundTripper
+}
+
+// newRateLimitTransport creates a rateLimitTransport allowing requestsPerSecond
+// requests per second, with bursts of up to one second worth of requests.
+func newRateLimitTransport(requestsPerSecond float64, next http.RoundTripper) *rateLimitTransport {
+	burst := int(math.Max(1, requestsPerSecond))
+
+	return &rateLimitTransport{
+		limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), burst),
+		next:    next,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// retryAfterTransport retries requests rejected with 429 Too Many Requests,
+// waiting for the delay requested by the server in the Retry-After header.
+type retryAfterTransport struct {
+	maxRetries int
+	next       http.RoundTripper
+}
+
+func (t *retryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= t.maxRetries {
+			return resp, err
+		}
+
+		delay, ok := retryAfterDelay(resp.Header.Get("Retry-After"), time.Now())
+		if !ok {
+			return resp, nil
+		}
+
+		// The body has to be replayed on the next attempt.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Helper function to parse a Retry-After header value, given either as
+// delay seconds or as an HTTP date
+func retryAfterDelay(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,201 @@
+package influxdbv2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+func TestRetryAfterTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryAfterTransport{maxRetries: 3, next: http.DefaultTransport}}
+
+	resp, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestRetryAfterTransport_MaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryAfterTransport{maxRetries: 2, next: http.DefaultTransport}}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		delay time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "5", delay: 5 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: "Mon, 01 Jan 2024 00:00:10 GMT", delay: 10 * time.Second, ok: true},
+		{value: "Sun, 31 Dec 2023 23:59:00 GMT", delay: 0, ok: true},
+		{value: "soon", ok: false},
+	}
+
+	for _, test := range tests {
+		delay, ok := retryAfterDelay(test.value, now)
+		if ok != test.ok || delay != test.delay {
+			t.Errorf("retryAfterDelay(%q) = %s, %t; expected %s, %t", test.value, delay, ok, test.delay, test.ok)
+		}
+	}
+}
+
+func TestTraceSpanTransport(t *testing.T) {
+	var spans []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var span map[string]any
+		if err := json.Unmarshal([]byte(r.Header.Get(traceSpanHeader)), &span); err != nil {
+			t.Errorf("could not decode the %s header: %s", traceSpanHeader, err)
+		}
+		spans = append(spans, span)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &traceSpanTransport{traceID: "terraform-run-42", next: http.DefaultTransport}}
+	for range 2 {
+		resp, err := client.Get(server.URL + "/api/v2/buckets")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(spans))
+	}
+	for _, span := range spans {
+		if span["trace_id"] != "terraform-run-42" {
+			t.Errorf("expected the configured trace ID, got %v", span["trace_id"])
+		}
+		if spanID, _ := span["span_id"].(string); len(spanID) != 16 {
+			t.Errorf("expected a 64-bit hexadecimal span ID, got %v", span["span_id"])
+		}
+	}
+	if spans[0]["span_id"] == spans[1]["span_id"] {
+		t.Errorf("expected a new span ID for each request, got %v twice", spans[0]["span_id"])
+	}
+}
+
+func TestLatencyLogTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v2/buckets", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &http.Client{Transport: &latencyLogTransport{next: http.DefaultTransport}}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error decoding logs: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(entries))
+	}
+
+	entry := entries[0]
+	if entry["method"] != http.MethodGet || entry["path"] != "/api/v2/buckets" {
+		t.Errorf("unexpected request fields: %v", entry)
+	}
+	if entry["status"] != float64(http.StatusNoContent) {
+		t.Errorf("expected status %d, got %v", http.StatusNoContent, entry["status"])
+	}
+	if _, ok := entry["duration_ms"]; !ok {
+		t.Errorf("expected a duration_ms field: %v", entry)
+	}
+}
+
+func TestConfigureConnectionPool(t *testing.T) {
+	tests := []struct {
+		name                    string
+		maxIdleConns            types.Int64
+		maxConnsPerHost         types.Int64
+		expectedMaxIdleConns    int
+		expectedMaxConnsPerHost int
+	}{
+		{name: "defaults", maxIdleConns: types.Int64Null(), maxConnsPerHost: types.Int64Null(), expectedMaxIdleConns: 100, expectedMaxConnsPerHost: 0},
+		{name: "idle connections", maxIdleConns: types.Int64Value(500), maxConnsPerHost: types.Int64Null(), expectedMaxIdleConns: 500, expectedMaxConnsPerHost: 0},
+		{name: "connections per host", maxIdleConns: types.Int64Null(), maxConnsPerHost: types.Int64Value(20), expectedMaxIdleConns: 100, expectedMaxConnsPerHost: 20},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := influxdb2.DefaultOptions().HTTPClient()
+			configureConnectionPool(client, test.maxIdleConns, test.maxConnsPerHost)
+
+			transport := client.Transport.(*http.Transport)
+			if transport.MaxIdleConns != test.expectedMaxIdleConns || transport.MaxIdleConnsPerHost != test.expectedMaxIdleConns {
+				t.Errorf("expected %d idle connections, got %d (%d per host)", test.expectedMaxIdleConns, transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+			}
+			if transport.MaxConnsPerHost != test.expectedMaxConnsPerHost {
+				t.Errorf("expected %d connections per host, got %d", test.expectedMaxConnsPerHost, transport.MaxConnsPerHost)
+			}
+		})
+	}
+}